@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// awsArn is an Amazon Resource Name, in the format
+// arn:PARTITION:SERVICE:REGION:ACCOUNT:RESOURCE. The region and account are
+// empty for resources of global services and some others.
+type awsArn struct {
+	Partition string
+	Service   string
+	Region    string
+	AccountID string
+	Resource  string
+}
+
+// parseArn parses an Amazon Resource Name.
+func parseArn(arn string) (*awsArn, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return nil, fmt.Errorf("Unable to parse invalid ARN: %q", arn)
+	}
+	if parts[1] == "" || parts[2] == "" || parts[5] == "" {
+		return nil, fmt.Errorf("Unable to parse invalid ARN: %q", arn)
+	}
+
+	return &awsArn{
+		Partition: parts[1],
+		Service:   parts[2],
+		Region:    parts[3],
+		AccountID: parts[4],
+		Resource:  parts[5],
+	}, nil
+}
+
+func (a *awsArn) String() string {
+	return strings.Join([]string{"arn", a.Partition, a.Service, a.Region, a.AccountID, a.Resource}, ":")
+}
+
+// ResourceType returns the type of the resource, e.g. "role" for
+// arn:aws:iam::123456789012:role/path/name. It is empty for ARNs whose
+// resource is a plain name, like those of SNS topics.
+func (a *awsArn) ResourceType() string {
+	if i := strings.IndexAny(a.Resource, "/:"); i >= 0 {
+		return a.Resource[:i]
+	}
+	return ""
+}
+
+// ResourceID returns the resource without its type, e.g. "path/name" for
+// arn:aws:iam::123456789012:role/path/name.
+func (a *awsArn) ResourceID() string {
+	if i := strings.IndexAny(a.Resource, "/:"); i >= 0 {
+		return a.Resource[i+1:]
+	}
+	return a.Resource
+}
+
+// ResourceName returns the last element of the resource ID, which for IAM
+// resources is their name without the path.
+func (a *awsArn) ResourceName() string {
+	id := a.ResourceID()
+	return id[strings.LastIndex(id, "/")+1:]
+}
+
+var (
+	awsArnPartitionRegexp = regexp.MustCompile(`^aws(-[a-z]+)*$`)
+	awsArnServiceRegexp   = regexp.MustCompile(`^[a-z0-9-]+$`)
+	awsArnRegionRegexp    = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-\d$`)
+	awsArnAccountRegexp   = regexp.MustCompile(`^\d{12}$`)
+)
+
+// awsArnFormat describes the ARNs of a service.
+type awsArnFormat struct {
+	// Global services have no region in their ARNs, the others always do.
+	Global bool
+
+	// Resource matches the resource part of the ARNs.
+	Resource *regexp.Regexp
+}
+
+// awsArnFormats are the ARN formats of the services whose ARNs are checked
+// beyond the general format.
+var awsArnFormats = map[string]awsArnFormat{
+	"acm":                  {Resource: regexp.MustCompile(`^certificate/.+$`)},
+	"cloudfront":           {Global: true},
+	"dynamodb":             {Resource: regexp.MustCompile(`^(table|global-table)/.+$`)},
+	"ecr":                  {Resource: regexp.MustCompile(`^repository/.+$`)},
+	"ecs":                  {Resource: regexp.MustCompile(`^[a-z-]+/.+$`)},
+	"elasticloadbalancing": {Resource: regexp.MustCompile(`^(loadbalancer|targetgroup|listener|listener-rule)/.+$`)},
+	"es":                   {Resource: regexp.MustCompile(`^domain/.+$`)},
+	"firehose":             {Resource: regexp.MustCompile(`^deliverystream/.+$`)},
+	"iam":                  {Global: true, Resource: regexp.MustCompile(`^(root|[a-z-]+/.+)$`)},
+	"kinesis":              {Resource: regexp.MustCompile(`^stream/.+$`)},
+	"kms":                  {Resource: regexp.MustCompile(`^(key|alias)/.+$`)},
+	"lambda":               {Resource: regexp.MustCompile(`^(function|event-source-mapping):.+$`)},
+	"logs":                 {Resource: regexp.MustCompile(`^(log-group|destination):.+$`)},
+	"rds":                  {Resource: regexp.MustCompile(`^[a-z-]+:.+$`)},
+	"route53":              {Global: true},
+	"sns":                  {Resource: regexp.MustCompile(`^[\w.-]+(:[\w-]+)?$`)},
+	"sqs":                  {Resource: regexp.MustCompile(`^[\w.-]+$`)},
+	"states":               {Resource: regexp.MustCompile(`^(stateMachine|activity|execution):.+$`)},
+	"sts":                  {Global: true},
+	"waf":                  {Global: true},
+}
+
+// Validate checks the format of the ARN, including the format of the resource
+// for known services.
+func (a *awsArn) Validate() error {
+	if !awsArnPartitionRegexp.MatchString(a.Partition) {
+		return fmt.Errorf("invalid partition %q", a.Partition)
+	}
+	if !awsArnServiceRegexp.MatchString(a.Service) {
+		return fmt.Errorf("invalid service %q", a.Service)
+	}
+	if a.Region != "" {
+		if !awsArnRegionRegexp.MatchString(a.Region) {
+			return fmt.Errorf("invalid region %q", a.Region)
+		}
+		if partition := awsPartitionForRegion(a.Region); partition != a.Partition {
+			return fmt.Errorf("region %s is in partition %s, not %s", a.Region, partition, a.Partition)
+		}
+	}
+	// AWS managed IAM policies are owned by the "aws" account
+	if a.AccountID != "" && !awsArnAccountRegexp.MatchString(a.AccountID) && !(a.Service == "iam" && a.AccountID == "aws") {
+		return fmt.Errorf("invalid account ID %q", a.AccountID)
+	}
+
+	format, ok := awsArnFormats[a.Service]
+	if !ok {
+		return nil
+	}
+	if format.Global && a.Region != "" {
+		return fmt.Errorf("%s ARNs have no region", a.Service)
+	}
+	if !format.Global && a.Region == "" {
+		return fmt.Errorf("%s ARNs need a region", a.Service)
+	}
+	if format.Resource != nil && !format.Resource.MatchString(a.Resource) {
+		return fmt.Errorf("invalid %s resource %q", a.Service, a.Resource)
+	}
+
+	return nil
+}
+
+// awsPartitionForRegion returns the partition of a region.
+func awsPartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	}
+	return "aws"
+}
+
+// validateArnOf returns a SchemaValidateFunc checking that a value is a valid
+// ARN of the given service and, unless empty, resource type.
+func validateArnOf(service, resourceType string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "" {
+			return
+		}
+
+		arn, err := validateArnFormat(value, k)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+		if service != "" && arn.Service != service {
+			errors = append(errors, fmt.Errorf("%q must be the ARN of a %s resource: %q", k, service, value))
+			return
+		}
+		if resourceType != "" && arn.ResourceType() != resourceType {
+			errors = append(errors, fmt.Errorf("%q must be the ARN of a %s %s: %q", k, service, resourceType, value))
+		}
+		return
+	}
+}
+
+// validateArnOfServices returns a SchemaValidateFunc checking that a value is
+// a valid ARN of one of the given services, for arguments that accept the
+// resources of several services, like SNS topics and SQS queues.
+func validateArnOfServices(services ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "" {
+			return
+		}
+
+		arn, err := validateArnFormat(value, k)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+		for _, service := range services {
+			if arn.Service == service {
+				return
+			}
+		}
+		errors = append(errors, fmt.Errorf("%q must be the ARN of a resource of %s: %q", k, strings.Join(services, " or "), value))
+		return
+	}
+}
+
+// validateArnFormat parses the value of argument k as an ARN and checks its
+// format.
+func validateArnFormat(value, k string) (*awsArn, error) {
+	arn, err := parseArn(value)
+	if err != nil {
+		return nil, fmt.Errorf("%q doesn't look like a valid ARN: %q", k, value)
+	}
+	if err := arn.Validate(); err != nil {
+		return nil, fmt.Errorf("%q doesn't look like a valid ARN (%s): %q", k, err, value)
+	}
+	return arn, nil
+}
+
+// arnNameEquivalent reports whether two values refer to the same resource of
+// the given service and resource type, each given either as its name or as
+// its ARN.
+func arnNameEquivalent(old, new, service, resourceType string) bool {
+	name := func(v string) string {
+		arn, err := parseArn(v)
+		if err != nil {
+			return v
+		}
+		if arn.Service != service || arn.ResourceType() != resourceType {
+			return ""
+		}
+		return arn.ResourceName()
+	}
+
+	if old == "" || new == "" {
+		return false
+	}
+	oldName := name(old)
+	return oldName != "" && oldName == name(new)
+}
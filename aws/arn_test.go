@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestParseArn(t *testing.T) {
+	cases := []struct {
+		Arn      string
+		Expected *awsArn
+	}{
+		{
+			Arn: "arn:aws:iam::123456789012:role/path/name",
+			Expected: &awsArn{
+				Partition: "aws",
+				Service:   "iam",
+				AccountID: "123456789012",
+				Resource:  "role/path/name",
+			},
+		},
+		{
+			Arn: "arn:aws-us-gov:sqs:us-gov-west-1:123456789012:queue",
+			Expected: &awsArn{
+				Partition: "aws-us-gov",
+				Service:   "sqs",
+				Region:    "us-gov-west-1",
+				AccountID: "123456789012",
+				Resource:  "queue",
+			},
+		},
+		{
+			Arn: "arn:aws:s3:::bucket/key:with:colons",
+			Expected: &awsArn{
+				Partition: "aws",
+				Service:   "s3",
+				Resource:  "bucket/key:with:colons",
+			},
+		},
+		{Arn: "blablah"},
+		{Arn: "arn:aws:iam::123456789012"},
+		{Arn: "arn:aws:iam::123456789012:"},
+		{Arn: "urn:aws:iam::123456789012:role/name"},
+	}
+
+	for _, tc := range cases {
+		arn, err := parseArn(tc.Arn)
+		if tc.Expected == nil {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.Arn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", tc.Arn, err)
+			continue
+		}
+		if !reflect.DeepEqual(arn, tc.Expected) {
+			t.Errorf("%s: expected %#v, got %#v", tc.Arn, tc.Expected, arn)
+		}
+		if arn.String() != tc.Arn {
+			t.Errorf("%s: expected String() to return the ARN, got %s", tc.Arn, arn)
+		}
+	}
+}
+
+func TestAwsArnResource(t *testing.T) {
+	cases := []struct {
+		Arn  string
+		Type string
+		ID   string
+		Name string
+	}{
+		{"arn:aws:iam::123456789012:role/path/name", "role", "path/name", "name"},
+		{"arn:aws:lambda:us-east-1:123456789012:function:name", "function", "name", "name"},
+		{"arn:aws:sns:us-east-1:123456789012:topic", "", "topic", "topic"},
+		{"arn:aws:rds:us-east-1:123456789012:db:mysql-db", "db", "mysql-db", "mysql-db"},
+	}
+
+	for _, tc := range cases {
+		arn, err := parseArn(tc.Arn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := arn.ResourceType(); v != tc.Type {
+			t.Errorf("%s: expected resource type %q, got %q", tc.Arn, tc.Type, v)
+		}
+		if v := arn.ResourceID(); v != tc.ID {
+			t.Errorf("%s: expected resource ID %q, got %q", tc.Arn, tc.ID, v)
+		}
+		if v := arn.ResourceName(); v != tc.Name {
+			t.Errorf("%s: expected resource name %q, got %q", tc.Arn, tc.Name, v)
+		}
+	}
+}
+
+func TestAwsPartitionForRegion(t *testing.T) {
+	cases := map[string]string{
+		"us-east-1":     "aws",
+		"eu-central-1":  "aws",
+		"cn-north-1":    "aws-cn",
+		"us-gov-west-1": "aws-us-gov",
+	}
+
+	for region, expected := range cases {
+		if partition := awsPartitionForRegion(region); partition != expected {
+			t.Errorf("%s: expected partition %s, got %s", region, expected, partition)
+		}
+	}
+}
+
+func TestProviderArnArgumentsValidated(t *testing.T) {
+	// Arguments named like ARNs that accept other values as well
+	unvalidated := map[string]bool{
+		"aws_ssm_maintenance_window_task.task_arn": true,
+	}
+	// Arguments that accept the ARNs of any service
+	anyService := map[string]bool{
+		"aws_lambda_permission.source_arn": true,
+	}
+
+	const malformedArn = "arn:aws:iam:123456789012:role/name"
+	// No argument takes a SimpleDB domain
+	const wrongServiceArn = "arn:aws:sdb:us-east-1:123456789012:domain/name"
+
+	var walk func(prefix string, m map[string]*schema.Schema)
+	walk = func(prefix string, m map[string]*schema.Schema) {
+		for k, s := range m {
+			key := prefix + "." + k
+			if r, ok := s.Elem.(*schema.Resource); ok {
+				walk(key, r.Schema)
+				continue
+			}
+			if !s.Optional && !s.Required {
+				continue
+			}
+			if !strings.HasSuffix(k, "_arn") && !strings.HasSuffix(k, "_arns") {
+				continue
+			}
+
+			name := strings.SplitN(key, ".", 2)[0] + "." + k
+			if unvalidated[name] {
+				continue
+			}
+
+			validate := s.ValidateFunc
+			if e, ok := s.Elem.(*schema.Schema); ok {
+				validate = e.ValidateFunc
+			}
+			if validate == nil {
+				t.Errorf("%s isn't validated as an ARN", key)
+				continue
+			}
+
+			if _, errors := validate(malformedArn, k); len(errors) == 0 {
+				t.Errorf("%s: expected an error for the malformed ARN %s", key, malformedArn)
+			}
+			if anyService[name] {
+				continue
+			}
+			if _, errors := validate(wrongServiceArn, k); len(errors) == 0 {
+				t.Errorf("%s: expected an error for the ARN of another service %s", key, wrongServiceArn)
+			}
+		}
+	}
+
+	for name, r := range Provider().(*schema.Provider).ResourcesMap {
+		walk(name, r.Schema)
+	}
+}
@@ -0,0 +1,412 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// awsAuditLogReadOnlyPrefixes are the prefixes of the names of API operations
+// that don't change anything, which are left out of the audit log.
+var awsAuditLogReadOnlyPrefixes = []string{
+	"BatchGet",
+	"Check",
+	"Describe",
+	"Download",
+	"Estimate",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Preview",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Simulate",
+	"Test",
+	"Validate",
+}
+
+// awsAuditLogRedactedFields are the names of the parameters whose values never
+// make it to the audit log: the members of the AWS API shapes and the keys of
+// the attribute maps (e.g. of OpsWorks layers) that carry secrets. Names are
+// matched exactly, so that e.g. SecretId or MinimumPasswordLength are kept.
+var awsAuditLogRedactedFields = map[string]bool{
+	"AuthToken":                true,
+	"CertificatePrivateKey":    true,
+	"CiphertextBlob":           true,
+	"ClientSecret":             true,
+	"CopySourceSSECustomerKey": true,
+	"CustomUserData":           true,
+	"DbPassword":               true,
+	"GangliaPassword":          true,
+	"HaproxyStatsPassword":     true,
+	"HsmPartitionPassword":     true,
+	"MasterUserPassword":       true,
+	"MysqlRootPassword":        true,
+	"NewPassword":              true,
+	"OldPassword":              true,
+	"Password":                 true,
+	"PasswordData":             true,
+	"Plaintext":                true,
+	"PreviousPassword":         true,
+	"PrivateKey":               true,
+	"PrivateKeyBase64":         true,
+	"ProposedPassword":         true,
+	"SSECustomerKey":           true,
+	"Secret":                   true,
+	"SecretAccessKey":          true,
+	"SecretHash":               true,
+	"SecretKey":                true,
+	"SecretString":             true,
+	"ServicePassword":          true,
+	"SessionToken":             true,
+	"SharedSecret":             true,
+	"TdeCredentialPassword":    true,
+	"TemporaryPassword":        true,
+	"TrustPassword":            true,
+	"UserData":                 true,
+}
+
+// awsAuditLogRedactedPaths are the parameters of specific API operations,
+// keyed by service and operation, whose values are redacted regardless of
+// their names because they commonly carry secrets. Paths are dot-separated
+// parameter names, where "*" stands for every element of a list or every value
+// of a map.
+var awsAuditLogRedactedPaths = map[string][]string{
+	"batch:RegisterJobDefinition": {"ContainerProperties.Environment.*.Value"},
+
+	"codebuild:CreateProject": {"Environment.EnvironmentVariables.*.Value"},
+	"codebuild:UpdateProject": {"Environment.EnvironmentVariables.*.Value"},
+
+	"ecs:RegisterTaskDefinition": {"ContainerDefinitions.*.Environment.*.Value"},
+	"ecs:RunTask":                {"Overrides.ContainerOverrides.*.Environment.*.Value"},
+	"ecs:StartTask":              {"Overrides.ContainerOverrides.*.Environment.*.Value"},
+
+	"elasticbeanstalk:CreateConfigurationTemplate": {"OptionSettings.*.Value"},
+	"elasticbeanstalk:CreateEnvironment":           {"OptionSettings.*.Value"},
+	"elasticbeanstalk:UpdateConfigurationTemplate": {"OptionSettings.*.Value"},
+	"elasticbeanstalk:UpdateEnvironment":           {"OptionSettings.*.Value"},
+
+	"lambda:CreateFunction":              {"Environment.Variables.*"},
+	"lambda:UpdateFunctionConfiguration": {"Environment.Variables.*"},
+
+	"ssm:PutParameter": {"Value"},
+}
+
+// awsAuditLogIdentifierSuffixes are the suffixes of the names of parameters
+// and results that identify the resource an API operation acts on.
+var awsAuditLogIdentifierSuffixes = []string{
+	"Arn",
+	"ARN",
+	"Bucket",
+	"Id",
+	"Identifier",
+	"Identifiers",
+	"Ids",
+	"Name",
+	"Names",
+}
+
+// awsAuditLog appends a JSON line for every mutating AWS API call to a file.
+type awsAuditLog struct {
+	sync.Mutex
+
+	w         io.Writer
+	accountID string
+}
+
+// awsAuditLogEntry is a single line of the audit log.
+type awsAuditLogEntry struct {
+	Time        string                 `json:"time"`
+	Service     string                 `json:"service"`
+	Operation   string                 `json:"operation"`
+	Region      string                 `json:"region"`
+	AccountID   string                 `json:"account_id,omitempty"`
+	Resources   []string               `json:"resources,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+	RequestID   string                 `json:"request_id,omitempty"`
+	Retries     int                    `json:"retries"`
+	Success     bool                   `json:"success"`
+	ErrorCode   string                 `json:"error_code,omitempty"`
+	ErrorString string                 `json:"error,omitempty"`
+}
+
+func newAwsAuditLog(path string) (*awsAuditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening audit log %s: %s", path, err)
+	}
+
+	return &awsAuditLog{w: f}, nil
+}
+
+// setAccountID sets the account recorded for every following call. It isn't
+// known until the service clients are set up.
+func (l *awsAuditLog) setAccountID(accountID string) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.accountID = accountID
+}
+
+// handlers installs the request handler writing the audit log.
+func (l *awsAuditLog) handlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.AuditLogCompleteHandler",
+		Fn:   l.log,
+	})
+}
+
+func (l *awsAuditLog) log(r *request.Request) {
+	if r.Operation == nil || !awsAuditLogMutating(r.Operation.Name) {
+		return
+	}
+
+	entry := awsAuditLogEntry{
+		Time:       time.Now().UTC().Format(time.RFC3339),
+		Service:    r.ClientInfo.ServiceName,
+		Operation:  r.Operation.Name,
+		Region:     aws.StringValue(r.Config.Region),
+		Resources:  awsAuditLogResources(r.Params, r.Data),
+		Parameters: awsAuditLogParameters(r.ClientInfo.ServiceName, r.Operation.Name, r.Params),
+		RequestID:  r.RequestID,
+		Retries:    r.RetryCount,
+		Success:    r.Error == nil,
+	}
+	if r.Error != nil {
+		entry.ErrorString = r.Error.Error()
+		if awsErr, ok := r.Error.(awserr.Error); ok {
+			entry.ErrorCode = awsErr.Code()
+			entry.ErrorString = awsErr.Message()
+		}
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	entry.AccountID = l.accountID
+	b, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] Error encoding audit log entry for %s %s: %s", entry.Service, entry.Operation, err)
+		return
+	}
+	if _, err := l.w.Write(append(b, '\n')); err != nil {
+		log.Printf("[WARN] Error writing audit log entry for %s %s: %s", entry.Service, entry.Operation, err)
+	}
+}
+
+// awsAuditLogMutating reports whether an API operation may change anything.
+func awsAuditLogMutating(operation string) bool {
+	for _, prefix := range awsAuditLogReadOnlyPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// awsAuditLogRedacted reports whether the value of a parameter must be
+// redacted.
+func awsAuditLogRedacted(name string) bool {
+	return awsAuditLogRedactedFields[name]
+}
+
+// awsAuditLogResources returns the identifiers of the resources of an API
+// call, found in the top-level input parameters and in the results up to one
+// structure deep (e.g. the VpcId of the Vpc returned by CreateVpc).
+func awsAuditLogResources(params, data interface{}) []string {
+	var resources []string
+	seen := make(map[string]bool)
+
+	var collect func(v reflect.Value, depth int)
+	collect = func(v reflect.Value, depth int) {
+		v = reflect.Indirect(v)
+		if v.Kind() != reflect.Struct {
+			return
+		}
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			fv := reflect.Indirect(v.Field(i))
+
+			if fv.Kind() == reflect.Struct && depth > 0 {
+				collect(fv, depth-1)
+				continue
+			}
+			if !awsAuditLogIdentifier(field.Name) || awsAuditLogRedacted(field.Name) {
+				continue
+			}
+
+			var ids []string
+			switch fv.Kind() {
+			case reflect.String:
+				ids = append(ids, fv.String())
+			case reflect.Slice:
+				for j := 0; j < fv.Len(); j++ {
+					if e := reflect.Indirect(fv.Index(j)); e.Kind() == reflect.String {
+						ids = append(ids, e.String())
+					}
+				}
+			}
+			for _, id := range ids {
+				if id != "" && !seen[id] {
+					seen[id] = true
+					resources = append(resources, id)
+				}
+			}
+		}
+	}
+
+	collect(reflect.ValueOf(params), 0)
+	collect(reflect.ValueOf(data), 1)
+
+	return resources
+}
+
+func awsAuditLogIdentifier(name string) bool {
+	for _, suffix := range awsAuditLogIdentifierSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// awsAuditLogParameters returns the input parameters of an API call as a map,
+// with the values of sensitive parameters redacted. Binary data and streams
+// are replaced by their size.
+func awsAuditLogParameters(service, operation string, params interface{}) map[string]interface{} {
+	v, ok := awsAuditLogValue(reflect.ValueOf(params)).(map[string]interface{})
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	for _, path := range awsAuditLogRedactedPaths[service+":"+operation] {
+		awsAuditLogRedactPath(v, strings.Split(path, "."))
+	}
+	return v
+}
+
+// awsAuditLogRedactPath redacts the values at a path of the parameters
+// returned by awsAuditLogValue.
+func awsAuditLogRedactPath(v interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if path[0] != "*" && path[0] != k {
+				continue
+			}
+			if len(path) == 1 {
+				if e != nil {
+					v[k] = "<redacted>"
+				}
+				continue
+			}
+			awsAuditLogRedactPath(e, path[1:])
+		}
+	case []interface{}:
+		if path[0] != "*" {
+			return
+		}
+		for i, e := range v {
+			if len(path) == 1 {
+				if e != nil {
+					v[i] = "<redacted>"
+				}
+				continue
+			}
+			awsAuditLogRedactPath(e, path[1:])
+		}
+	}
+}
+
+func awsAuditLogValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if _, ok := v.Interface().(io.Reader); ok {
+			return "<stream>"
+		}
+		return awsAuditLogValue(v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t.UTC().Format(time.RFC3339)
+		}
+		m := make(map[string]interface{})
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			fv := v.Field(i)
+			if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Slice || fv.Kind() == reflect.Map || fv.Kind() == reflect.Interface) && fv.IsNil() {
+				continue
+			}
+			if awsAuditLogRedacted(field.Name) {
+				m[field.Name] = "<redacted>"
+				continue
+			}
+			m[field.Name] = awsAuditLogValue(fv)
+		}
+		return m
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<%d bytes>", v.Len())
+		}
+		s := make([]interface{}, v.Len())
+		for i := range s {
+			s[i] = awsAuditLogValue(v.Index(i))
+		}
+		return s
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			name := fmt.Sprint(k.Interface())
+			if awsAuditLogRedacted(name) {
+				m[name] = "<redacted>"
+				continue
+			}
+			m[name] = awsAuditLogValue(v.MapIndex(k))
+		}
+		return m
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+
+	return nil
+}
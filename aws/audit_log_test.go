@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func testAwsAuditLogRequest(service, operation string, params, data interface{}, err error) *request.Request {
+	return &request.Request{
+		Config:     aws.Config{Region: aws.String("us-west-2")},
+		ClientInfo: metadata.ClientInfo{ServiceName: service},
+		Operation:  &request.Operation{Name: operation},
+		Params:     params,
+		Data:       data,
+		Error:      err,
+		RequestID:  "request-" + operation,
+	}
+}
+
+func TestAwsAuditLog(t *testing.T) {
+	var buf bytes.Buffer
+	l := &awsAuditLog{w: &buf}
+	l.setAccountID("123456789012")
+
+	l.log(testAwsAuditLogRequest("ec2", "DescribeVpcs", &ec2.DescribeVpcsInput{}, &ec2.DescribeVpcsOutput{}, nil))
+	l.log(testAwsAuditLogRequest("ec2", "CreateVpc",
+		&ec2.CreateVpcInput{CidrBlock: aws.String("10.0.0.0/16")},
+		&ec2.CreateVpcOutput{Vpc: &ec2.Vpc{VpcId: aws.String("vpc-12345678")}},
+		nil))
+	l.log(testAwsAuditLogRequest("ec2", "RunInstances",
+		&ec2.RunInstancesInput{
+			ImageId:  aws.String("ami-12345678"),
+			UserData: aws.String("c2VjcmV0"),
+			MinCount: aws.Int64(1),
+			MaxCount: aws.Int64(1),
+		},
+		&ec2.Reservation{},
+		nil))
+	l.log(testAwsAuditLogRequest("rds", "CreateDBInstance",
+		&rds.CreateDBInstanceInput{
+			DBInstanceIdentifier: aws.String("db"),
+			MasterUsername:       aws.String("admin"),
+			MasterUserPassword:   aws.String("hunter22"),
+		},
+		&rds.CreateDBInstanceOutput{},
+		awserr.New("DBInstanceAlreadyExists", "DB instance already exists", nil)))
+
+	if strings.Contains(buf.String(), "hunter22") || strings.Contains(buf.String(), "c2VjcmV0") {
+		t.Fatalf("Expected sensitive parameters to be redacted, got:\n%s", buf.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 audit log lines, got %d:\n%s", len(lines), buf.String())
+	}
+
+	var entries []awsAuditLogEntry
+	for _, line := range lines {
+		var entry awsAuditLogEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Error decoding audit log line %q: %s", line, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	vpc := entries[0]
+	if vpc.Operation != "CreateVpc" || vpc.Service != "ec2" || vpc.Region != "us-west-2" ||
+		vpc.AccountID != "123456789012" || vpc.RequestID != "request-CreateVpc" || !vpc.Success {
+		t.Fatalf("Unexpected audit log entry: %#v", vpc)
+	}
+	if !reflect.DeepEqual(vpc.Resources, []string{"vpc-12345678"}) {
+		t.Fatalf("Expected resources [vpc-12345678], got %q", vpc.Resources)
+	}
+	if vpc.Parameters["CidrBlock"] != "10.0.0.0/16" {
+		t.Fatalf("Expected CidrBlock parameter, got %#v", vpc.Parameters)
+	}
+
+	if v := entries[1].Parameters["UserData"]; v != "<redacted>" {
+		t.Fatalf("Expected UserData to be redacted, got %#v", v)
+	}
+
+	db := entries[2]
+	if db.Success || db.ErrorCode != "DBInstanceAlreadyExists" {
+		t.Fatalf("Expected a failed call with error code DBInstanceAlreadyExists, got %#v", db)
+	}
+	if v := db.Parameters["MasterUserPassword"]; v != "<redacted>" {
+		t.Fatalf("Expected MasterUserPassword to be redacted, got %#v", v)
+	}
+	if !reflect.DeepEqual(db.Resources, []string{"db"}) {
+		t.Fatalf("Expected resources [db], got %q", db.Resources)
+	}
+}
+
+func TestAwsAuditLogParameters_redactedFields(t *testing.T) {
+	cases := []struct {
+		Params   interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Params: &iam.UpdateAccountPasswordPolicyInput{
+				AllowUsersToChangePassword: aws.Bool(true),
+				MaxPasswordAge:             aws.Int64(90),
+				MinimumPasswordLength:      aws.Int64(14),
+			},
+			Expected: map[string]interface{}{
+				"AllowUsersToChangePassword": true,
+				"MaxPasswordAge":             int64(90),
+				"MinimumPasswordLength":      int64(14),
+			},
+		},
+		{
+			Params: &iam.ChangePasswordInput{
+				OldPassword: aws.String("hunter22"),
+				NewPassword: aws.String("hunter23"),
+			},
+			Expected: map[string]interface{}{
+				"OldPassword": "<redacted>",
+				"NewPassword": "<redacted>",
+			},
+		},
+		{
+			Params: &opsworks.UpdateLayerInput{
+				LayerId: aws.String("layer"),
+				Attributes: map[string]*string{
+					"MysqlRootPassword":           aws.String("hunter22"),
+					"MysqlRootPasswordUbiquitous": aws.String("true"),
+				},
+			},
+			Expected: map[string]interface{}{
+				"LayerId": "layer",
+				"Attributes": map[string]interface{}{
+					"MysqlRootPassword":           "<redacted>",
+					"MysqlRootPasswordUbiquitous": "true",
+				},
+			},
+		},
+		{
+			// The shape of PutSecretValueInput of Secrets Manager, which isn't vendored
+			Params: &struct {
+				SecretId     *string
+				SecretString *string
+			}{
+				SecretId:     aws.String("app/db"),
+				SecretString: aws.String("hunter22"),
+			},
+			Expected: map[string]interface{}{
+				"SecretId":     "app/db",
+				"SecretString": "<redacted>",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := awsAuditLogParameters("service", "Operation", tc.Params)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: Expected parameters:\n%#v\nGot:\n%#v", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAwsAuditLogParameters_redactedPaths(t *testing.T) {
+	cases := []struct {
+		Service   string
+		Operation string
+		Params    interface{}
+		Expected  map[string]interface{}
+	}{
+		{
+			Service:   "ssm",
+			Operation: "PutParameter",
+			Params: &ssm.PutParameterInput{
+				Name:  aws.String("/app/db-password"),
+				Type:  aws.String("SecureString"),
+				Value: aws.String("hunter22"),
+			},
+			Expected: map[string]interface{}{
+				"Name":  "/app/db-password",
+				"Type":  "SecureString",
+				"Value": "<redacted>",
+			},
+		},
+		{
+			Service:   "lambda",
+			Operation: "UpdateFunctionConfiguration",
+			Params: &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String("fn"),
+				Environment: &lambda.Environment{
+					Variables: map[string]*string{"API_KEY": aws.String("hunter22")},
+				},
+			},
+			Expected: map[string]interface{}{
+				"FunctionName": "fn",
+				"Environment": map[string]interface{}{
+					"Variables": map[string]interface{}{"API_KEY": "<redacted>"},
+				},
+			},
+		},
+		{
+			Service:   "ecs",
+			Operation: "RegisterTaskDefinition",
+			Params: &ecs.RegisterTaskDefinitionInput{
+				Family: aws.String("app"),
+				ContainerDefinitions: []*ecs.ContainerDefinition{
+					{
+						Name: aws.String("web"),
+						Environment: []*ecs.KeyValuePair{
+							{Name: aws.String("DB_PASSWORD"), Value: aws.String("hunter22")},
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"Family": "app",
+				"ContainerDefinitions": []interface{}{
+					map[string]interface{}{
+						"Name": "web",
+						"Environment": []interface{}{
+							map[string]interface{}{"Name": "DB_PASSWORD", "Value": "<redacted>"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Service:   "elasticbeanstalk",
+			Operation: "UpdateEnvironment",
+			Params: &elasticbeanstalk.UpdateEnvironmentInput{
+				EnvironmentName: aws.String("env"),
+				OptionSettings: []*elasticbeanstalk.ConfigurationOptionSetting{
+					{
+						Namespace:  aws.String("aws:elasticbeanstalk:application:environment"),
+						OptionName: aws.String("DB_PASSWORD"),
+						Value:      aws.String("hunter22"),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"EnvironmentName": "env",
+				"OptionSettings": []interface{}{
+					map[string]interface{}{
+						"Namespace":  "aws:elasticbeanstalk:application:environment",
+						"OptionName": "DB_PASSWORD",
+						"Value":      "<redacted>",
+					},
+				},
+			},
+		},
+		{
+			// Values of other operations are kept
+			Service:   "ssm",
+			Operation: "AddTagsToResource",
+			Params: &ssm.AddTagsToResourceInput{
+				ResourceId: aws.String("/app/name"),
+				Tags:       []*ssm.Tag{{Key: aws.String("Name"), Value: aws.String("app")}},
+			},
+			Expected: map[string]interface{}{
+				"ResourceId": "/app/name",
+				"Tags": []interface{}{
+					map[string]interface{}{"Key": "Name", "Value": "app"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		v := awsAuditLogParameters(tc.Service, tc.Operation, tc.Params)
+		if !reflect.DeepEqual(v, tc.Expected) {
+			t.Errorf("%s %s: expected %#v, got %#v", tc.Service, tc.Operation, tc.Expected, v)
+		}
+	}
+}
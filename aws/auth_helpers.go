@@ -1,10 +1,13 @@
 package aws
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"os/exec"
 	"strings"
 	"time"
 
@@ -19,11 +22,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-multierror"
+	"github.com/mattn/go-shellwords"
 )
 
 func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string) (string, string, error) {
+	partition, accountId, _, err := getAccountInfo(iamconn, stsconn, authProviderName)
+	return partition, accountId, err
+}
+
+// getAccountInfo returns the partition and account ID like GetAccountInfo, as
+// well as the ARN of the caller it found them with. The ARN is empty when the
+// account ID was taken from one of the account's roles instead.
+func getAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string) (string, string, string, error) {
 	var errors error
 	// If we have creds from instance profile, we can use metadata API
 	if authProviderName == ec2rolecreds.ProviderName {
@@ -33,13 +44,13 @@ func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string)
 		setOptionalEndpoint(cfg)
 		sess, err := session.NewSession(cfg)
 		if err != nil {
-			return "", "", errwrap.Wrapf("Error creating AWS session: {{err}}", err)
+			return "", "", "", errwrap.Wrapf("Error creating AWS session: {{err}}", err)
 		}
 
 		metadataClient := ec2metadata.New(sess)
 		info, err := metadataClient.IAMInfo()
 		if err == nil {
-			return parseAccountInfoFromArn(info.InstanceProfileArn)
+			return parseAccountInfoFromCallerArn(info.InstanceProfileArn)
 		}
 		log.Printf("[DEBUG] Failed to get account info from metadata service: %s", err)
 		errors = multierror.Append(errors, err)
@@ -55,14 +66,14 @@ func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string)
 		log.Println("[DEBUG] Trying to get account ID via iam:GetUser")
 		outUser, err := iamconn.GetUser(nil)
 		if err == nil {
-			return parseAccountInfoFromArn(*outUser.User.Arn)
+			return parseAccountInfoFromCallerArn(*outUser.User.Arn)
 		}
 		errors = multierror.Append(errors, err)
 		awsErr, ok := err.(awserr.Error)
 		// AccessDenied and ValidationError can be raised
 		// if credentials belong to federated profile, so we ignore these
 		if !ok || (awsErr.Code() != "AccessDenied" && awsErr.Code() != "ValidationError" && awsErr.Code() != "InvalidClientTokenId") {
-			return "", "", fmt.Errorf("Failed getting account ID via 'iam:GetUser': %s", err)
+			return "", "", "", fmt.Errorf("Failed getting account ID via 'iam:GetUser': %s", err)
 		}
 		log.Printf("[DEBUG] Getting account ID via iam:GetUser failed: %s", err)
 	}
@@ -71,7 +82,7 @@ func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string)
 	log.Println("[DEBUG] Trying to get account ID via sts:GetCallerIdentity")
 	outCallerIdentity, err := stsconn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
 	if err == nil {
-		return parseAccountInfoFromArn(*outCallerIdentity.Arn)
+		return parseAccountInfoFromCallerArn(*outCallerIdentity.Arn)
 	}
 	log.Printf("[DEBUG] Getting account ID via sts:GetCallerIdentity failed: %s", err)
 	errors = multierror.Append(errors, err)
@@ -84,25 +95,31 @@ func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string)
 	if err != nil {
 		log.Printf("[DEBUG] Failed to get account ID via iam:ListRoles: %s", err)
 		errors = multierror.Append(errors, err)
-		return "", "", fmt.Errorf("Failed getting account ID via all available methods. Errors: %s", errors)
+		return "", "", "", fmt.Errorf("Failed getting account ID via all available methods. Errors: %s", errors)
 	}
 
 	if len(outRoles.Roles) < 1 {
 		err = fmt.Errorf("Failed to get account ID via iam:ListRoles: No roles available")
 		log.Printf("[DEBUG] %s", err)
 		errors = multierror.Append(errors, err)
-		return "", "", fmt.Errorf("Failed getting account ID via all available methods. Errors: %s", errors)
+		return "", "", "", fmt.Errorf("Failed getting account ID via all available methods. Errors: %s", errors)
 	}
 
-	return parseAccountInfoFromArn(*outRoles.Roles[0].Arn)
+	partition, accountId, err := parseAccountInfoFromArn(*outRoles.Roles[0].Arn)
+	return partition, accountId, "", err
+}
+
+func parseAccountInfoFromCallerArn(arn string) (string, string, string, error) {
+	partition, accountId, err := parseAccountInfoFromArn(arn)
+	return partition, accountId, arn, err
 }
 
 func parseAccountInfoFromArn(arn string) (string, string, error) {
-	parts := strings.Split(arn, ":")
-	if len(parts) < 5 {
+	a, err := parseArn(arn)
+	if err != nil {
 		return "", "", fmt.Errorf("Unable to parse ID from invalid ARN: %q", arn)
 	}
-	return parts[1], parts[4], nil
+	return a.Partition, a.AccountID, nil
 }
 
 // This function is responsible for reading credentials from the
@@ -117,14 +134,25 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 			SessionToken:    c.Token,
 		}},
 		&awsCredentials.EnvProvider{},
-		&awsCredentials.SharedCredentialsProvider{
-			Filename: c.CredsFilename,
-			Profile:  c.Profile,
-		},
 	}
 
+	// Profiles that assume a role, run a credential_process or use AWS SSO
+	// are only supported by our own parser of the shared config files. The
+	// profile is only consulted when no credentials are given explicitly or
+	// in the environment, as the providers above would be used anyway.
+	if !hasStaticOrEnvCredentials(c) {
+		if sharedConfigProvider := getSharedConfigProvider(c); sharedConfigProvider != nil {
+			providers = append(providers, sharedConfigProvider)
+		}
+	}
+
+	providers = append(providers, &awsCredentials.SharedCredentialsProvider{
+		Filename: c.CredsFilename,
+		Profile:  c.Profile,
+	})
+
 	// Build isolated HTTP client to avoid issues with globally-shared settings
-	client := cleanhttp.DefaultClient()
+	client := c.httpClient()
 
 	// Keep the default timeout (100ms) low as we don't want to wait in non-EC2 environments
 	client.Timeout = 100 * time.Millisecond
@@ -177,56 +205,78 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	}
 
 	// This is the "normal" flow (i.e. not assuming a role)
-	if c.AssumeRoleARN == "" {
+	if c.AssumeRoleWithWebIdentity == nil && len(c.AssumeRoles) == 0 {
 		return awsCredentials.NewChainCredentials(providers), nil
 	}
 
-	// Otherwise we need to construct and STS client with the main credentials, and verify
-	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
-
-	creds := awsCredentials.NewChainCredentials(providers)
-	cp, err := creds.Get()
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, errors.New(`No valid credential sources found for AWS Provider.
+	var creds *awsCredentials.Credentials
+	if c.AssumeRoleWithWebIdentity != nil {
+		// The web identity token replaces the credentials from the environment
+		var err error
+		creds, err = getAssumeRoleWithWebIdentityCredentials(c, c.AssumeRoleWithWebIdentity)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		creds = awsCredentials.NewChainCredentials(providers)
+		cp, err := creds.Get()
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+				return nil, errors.New(`No valid credential sources found for AWS Provider.
   Please see https://terraform.io/docs/providers/aws/index.html for more information on
   providing credentials for the AWS Provider`)
+			}
+
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
 		}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
 	}
 
-	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
-
-	awsConfig := &aws.Config{
-		Credentials:      creds,
-		Region:           aws.String(c.Region),
-		MaxRetries:       aws.Int(c.MaxRetries),
-		HTTPClient:       cleanhttp.DefaultClient(),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	// Then we need to construct an STS client with the credentials of the
+	// previous hop, and verify that we can assume each role in turn.
+	for _, assumeRole := range c.AssumeRoles {
+		var err error
+		creds, err = getAssumeRoleCredentials(c, creds, assumeRole)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	stsclient := sts.New(session.New(awsConfig))
+	return creds, nil
+}
+
+func getAssumeRoleCredentials(c *Config, creds *awsCredentials.Credentials, assumeRole AssumeRole) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, DurationSeconds: %d, MFASerial: %q)",
+		assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.Policy, assumeRole.DurationSeconds, assumeRole.MFASerial)
+
 	assumeRoleProvider := &stscreds.AssumeRoleProvider{
-		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+		Client:  sts.New(session.New(stsConfig(c, creds))),
+		RoleARN: assumeRole.RoleARN,
 	}
-	if c.AssumeRoleSessionName != "" {
-		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	if assumeRole.SessionName != "" {
+		assumeRoleProvider.RoleSessionName = assumeRole.SessionName
 	}
-	if c.AssumeRoleExternalID != "" {
-		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	if assumeRole.ExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(assumeRole.ExternalID)
 	}
-	if c.AssumeRolePolicy != "" {
-		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	if assumeRole.Policy != "" {
+		assumeRoleProvider.Policy = aws.String(assumeRole.Policy)
+	}
+	if assumeRole.DurationSeconds > 0 {
+		assumeRoleProvider.Duration = time.Duration(assumeRole.DurationSeconds) * time.Second
+	}
+	if assumeRole.MFASerial != "" {
+		assumeRoleProvider.SerialNumber = aws.String(assumeRole.MFASerial)
+		if assumeRole.TokenCodeCommand != "" {
+			assumeRoleProvider.TokenProvider = tokenCodeCommandProvider(assumeRole.TokenCodeCommand)
+		} else {
+			assumeRoleProvider.TokenCode = aws.String(assumeRole.TokenCode)
+		}
 	}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
-
-	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
-	_, err = assumeRoleCreds.Get()
+	assumeRoleCreds := awsCredentials.NewChainCredentials([]awsCredentials.Provider{assumeRoleProvider})
+	_, err := assumeRoleCreds.Get()
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
 			return nil, fmt.Errorf("The role %q cannot be assumed.\n\n"+
@@ -234,7 +284,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 				"    * The credentials used in order to assume the role are invalid\n"+
 				"    * The credentials do not have appropriate permission to assume the role\n"+
 				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
+				assumeRole.RoleARN)
 		}
 
 		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
@@ -243,6 +293,144 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	return assumeRoleCreds, nil
 }
 
+func getAssumeRoleWithWebIdentityCredentials(c *Config, assumeRole *AssumeRoleWithWebIdentity) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, WebIdentityTokenFile: %q, Policy: %q, DurationSeconds: %d)",
+		assumeRole.RoleARN, assumeRole.SessionName, assumeRole.WebIdentityTokenFile, assumeRole.Policy, assumeRole.DurationSeconds)
+
+	// AssumeRoleWithWebIdentity is authenticated by the token, not by a
+	// signature
+	provider := &webIdentityRoleProvider{
+		Client:          sts.New(session.New(stsConfig(c, awsCredentials.AnonymousCredentials))),
+		RoleARN:         assumeRole.RoleARN,
+		RoleSessionName: assumeRole.SessionName,
+		TokenFile:       assumeRole.WebIdentityTokenFile,
+	}
+	if assumeRole.Policy != "" {
+		provider.Policy = aws.String(assumeRole.Policy)
+	}
+	if assumeRole.DurationSeconds > 0 {
+		provider.Duration = time.Duration(assumeRole.DurationSeconds) * time.Second
+	}
+
+	creds := awsCredentials.NewCredentials(provider)
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("Error assuming role %q with web identity: %s", assumeRole.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+// hasStaticOrEnvCredentials returns whether credentials are set in the
+// provider configuration or in the environment.
+func hasStaticOrEnvCredentials(c *Config) bool {
+	if c.AccessKey != "" && c.SecretKey != "" {
+		return true
+	}
+	if _, err := (&awsCredentials.EnvProvider{}).Retrieve(); err == nil {
+		return true
+	}
+	return false
+}
+
+func stsConfig(c *Config, creds *awsCredentials.Credentials) *aws.Config {
+	return &aws.Config{
+		Credentials:      creds,
+		Region:           aws.String(c.Region),
+		Endpoint:         aws.String(c.Endpoints["sts"]),
+		MaxRetries:       aws.Int(c.MaxRetries),
+		HTTPClient:       c.httpClient(),
+		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	}
+}
+
+// tokenCodeCommandProvider returns a stscreds TokenProvider that runs the given
+// command and returns its output as the MFA token code. The command is run
+// each time the role's credentials are refreshed.
+func tokenCodeCommandProvider(command string) func() (string, error) {
+	return func() (string, error) {
+		out, err := runCredentialsCommand(command)
+		if err != nil {
+			return "", fmt.Errorf("Error running token_code_command: %s", err)
+		}
+
+		return strings.TrimSpace(string(out)), nil
+	}
+}
+
+// runCredentialsCommand runs a command given as a single string, split into
+// arguments like a shell would, and returns its output.
+func runCredentialsCommand(command string) ([]byte, error) {
+	args, err := shellwords.Parse(command)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing command %q: %s", command, err)
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("Empty command")
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%q: %s: %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return out, nil
+}
+
+// webIdentityRoleProvider retrieves credentials by assuming a role with an
+// OpenID Connect token read from a file. The file is read again whenever the
+// credentials are refreshed, as the tokens are typically short-lived and
+// rotated by whatever wrote the file.
+type webIdentityRoleProvider struct {
+	awsCredentials.Expiry
+
+	Client          *sts.STS
+	RoleARN         string
+	RoleSessionName string
+	TokenFile       string
+	Policy          *string
+	Duration        time.Duration
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := ioutil.ReadFile(p.TokenFile)
+	if err != nil {
+		return awsCredentials.Value{}, fmt.Errorf("Error reading web identity token file %q: %s", p.TokenFile, err)
+	}
+
+	sessionName := p.RoleSessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+	duration := p.Duration
+	if duration == 0 {
+		duration = stscreds.DefaultDuration
+	}
+
+	out, err := p.Client.AssumeRoleWithWebIdentity(&sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+		Policy:           p.Policy,
+		DurationSeconds:  aws.Int64(int64(duration / time.Second)),
+	})
+	if err != nil {
+		return awsCredentials.Value{}, err
+	}
+
+	// Refresh the credentials a minute before they expire
+	p.SetExpiration(*out.Credentials.Expiration, time.Minute)
+
+	return awsCredentials.Value{
+		AccessKeyID:     *out.Credentials.AccessKeyId,
+		SecretAccessKey: *out.Credentials.SecretAccessKey,
+		SessionToken:    *out.Credentials.SessionToken,
+		ProviderName:    "WebIdentityRoleProvider",
+	}, nil
+}
+
 func setOptionalEndpoint(cfg *aws.Config) string {
 	endpoint := os.Getenv("AWS_METADATA_URL")
 	if endpoint != "" {
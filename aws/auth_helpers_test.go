@@ -7,7 +7,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -240,6 +244,54 @@ func TestAWSGetAccountInfo_shouldBeValid_fromIamListRoles(t *testing.T) {
 	}
 }
 
+func TestAWSGetAccountInfo_callerArn(t *testing.T) {
+	iamEndpoints := []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=GetUser&Version=2010-05-08"},
+			Response: &awsMockResponse{403, iamResponse_GetUser_unauthorized, "text/xml"},
+		},
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=ListRoles&MaxItems=1&Version=2010-05-08"},
+			Response: &awsMockResponse{200, iamResponse_ListRoles_valid, "text/xml"},
+		},
+	}
+	closeIam, iamSess, err := getMockedAwsApiSession("IAM", iamEndpoints)
+	defer closeIam()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stsEndpoints := []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=GetCallerIdentity&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsResponse_GetCallerIdentity_valid, "text/xml"},
+		},
+	}
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", stsEndpoints)
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, arn, err := getAccountInfo(iam.New(iamSess), sts.New(stsSess), "")
+	if err != nil {
+		t.Fatalf("Getting account ID failed: %s", err)
+	}
+	if expected := "arn:aws:iam::123456789012:user/Alice"; arn != expected {
+		t.Fatalf("Expected caller ARN: %s, given: %s", expected, arn)
+	}
+
+	// The ARN of a role found with iam:ListRoles isn't the caller's.
+	stsEndpoints[0].Response = &awsMockResponse{403, stsResponse_GetCallerIdentity_unauthorized, "text/xml"}
+	_, _, arn, err = getAccountInfo(iam.New(iamSess), sts.New(stsSess), "")
+	if err != nil {
+		t.Fatalf("Getting account ID via ListRoles failed: %s", err)
+	}
+	if arn != "" {
+		t.Fatalf("Expected no caller ARN, given: %s", arn)
+	}
+}
+
 func TestAWSGetAccountInfo_shouldBeValid_federatedRole(t *testing.T) {
 	iamEndpoints := []*awsMockEndpoint{
 		{
@@ -671,6 +723,152 @@ func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 	}
 }
 
+func TestAWSGetCredentials_shouldChainAssumeRoles(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	requests, closeFunc := stsAssumeRoleMock(t)
+	defer closeFunc()
+
+	tokenFile, err := ioutil.TempFile(os.TempDir(), "terraform_aws_web_identity")
+	if err != nil {
+		t.Fatalf("Error creating temporary token file: %s", err)
+	}
+	defer os.Remove(tokenFile.Name())
+	if _, err := tokenFile.WriteString("web-identity-token\n"); err != nil {
+		t.Fatalf("Error writing temporary token file: %s", err)
+	}
+	tokenFile.Close()
+
+	cases := []struct {
+		Config   Config
+		Requests []string
+		Key      string
+	}{
+		{
+			Config: Config{
+				AccessKey: "base",
+				SecretKey: "base",
+				AssumeRoles: []AssumeRole{
+					{RoleARN: "arn:aws:iam::111111111111:role/hub", SessionName: "hub"},
+					{RoleARN: "arn:aws:iam::222222222222:role/workload", DurationSeconds: 3600, ExternalID: "ext"},
+				},
+			},
+			Requests: []string{
+				"base AssumeRole arn:aws:iam::111111111111:role/hub DurationSeconds=900",
+				"hub AssumeRole arn:aws:iam::222222222222:role/workload DurationSeconds=3600",
+			},
+			Key: "workload",
+		},
+		{
+			Config: Config{
+				AccessKey: "base",
+				SecretKey: "base",
+				AssumeRoles: []AssumeRole{
+					{RoleARN: "arn:aws:iam::111111111111:role/hub", MFASerial: "arn:aws:iam::000000000000:mfa/user", TokenCodeCommand: "echo 123456"},
+				},
+			},
+			Requests: []string{
+				"base AssumeRole arn:aws:iam::111111111111:role/hub DurationSeconds=900 TokenCode=123456",
+			},
+			Key: "hub",
+		},
+		{
+			Config: Config{
+				AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+					RoleARN:              "arn:aws:iam::111111111111:role/ci",
+					WebIdentityTokenFile: tokenFile.Name(),
+				},
+				AssumeRoles: []AssumeRole{
+					{RoleARN: "arn:aws:iam::222222222222:role/workload"},
+				},
+			},
+			Requests: []string{
+				" AssumeRoleWithWebIdentity arn:aws:iam::111111111111:role/ci DurationSeconds=900 WebIdentityToken=web-identity-token",
+				"ci AssumeRole arn:aws:iam::222222222222:role/workload DurationSeconds=900",
+			},
+			Key: "workload",
+		},
+	}
+
+	for i, tc := range cases {
+		*requests = nil
+		tc.Config.Region = "us-east-1"
+		tc.Config.SkipMetadataApiCheck = true
+		tc.Config.Endpoints = map[string]string{"sts": stsAssumeRoleMockURL}
+
+		creds, err := GetCredentials(&tc.Config)
+		if err != nil {
+			t.Fatalf("%d: Error getting creds: %s", i, err)
+		}
+
+		v, err := creds.Get()
+		if err != nil {
+			t.Fatalf("%d: Error getting creds: %s", i, err)
+		}
+		if v.AccessKeyID != tc.Key {
+			t.Fatalf("%d: AccessKeyID mismatch, expected: (%s), got (%s)", i, tc.Key, v.AccessKeyID)
+		}
+		if !reflect.DeepEqual(*requests, tc.Requests) {
+			t.Fatalf("%d: Requests mismatch, expected: %q, got %q", i, tc.Requests, *requests)
+		}
+	}
+}
+
+var stsAssumeRoleMockURL string
+
+// stsAssumeRoleMock starts an STS endpoint answering AssumeRole and
+// AssumeRoleWithWebIdentity calls with credentials whose access key is the
+// name of the role. It records the access key each call was signed with,
+// the action and its main parameters.
+func stsAssumeRoleMock(t *testing.T) (*[]string, func()) {
+	var requests []string
+	credentialRe := regexp.MustCompile(`Credential=([^/]*)/`)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		key := ""
+		if m := credentialRe.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			key = m[1]
+		}
+
+		action := r.Form.Get("Action")
+		request := fmt.Sprintf("%s %s %s DurationSeconds=%s", key, action, r.Form.Get("RoleArn"), r.Form.Get("DurationSeconds"))
+		if v := r.Form.Get("TokenCode"); v != "" {
+			request += " TokenCode=" + v
+		}
+		if v := r.Form.Get("WebIdentityToken"); v != "" {
+			request += " WebIdentityToken=" + v
+		}
+		requests = append(requests, request)
+
+		roleArn := r.Form.Get("RoleArn")
+		name := roleArn[strings.LastIndex(roleArn, "/")+1:]
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<%[1]sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <%[1]sResult>
+    <Credentials>
+      <AccessKeyId>%[2]s</AccessKeyId>
+      <SecretAccessKey>%[2]s</SecretAccessKey>
+      <SessionToken>%[2]s</SessionToken>
+      <Expiration>%[3]s</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>%[4]s</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:%[2]s</AssumedRoleId>
+    </AssumedRoleUser>
+  </%[1]sResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</%[1]sResponse>`, action, name, time.Now().Add(time.Hour).UTC().Format(time.RFC3339), roleArn)
+	}))
+	stsAssumeRoleMockURL = ts.URL
+
+	return &requests, ts.Close
+}
+
 func testGetAccountInfo(t *testing.T, iamSess, stsSess *session.Session, credProviderName string) {
 
 	iamConn := iam.New(iamSess)
@@ -1,11 +1,9 @@
 package aws
 
 import (
-	"crypto/tls"
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -17,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -30,6 +29,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
@@ -60,6 +60,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/simpledb"
@@ -71,44 +72,44 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 type Config struct {
-	AccessKey     string
-	SecretKey     string
-	CredsFilename string
-	Profile       string
-	Token         string
-	Region        string
-	MaxRetries    int
-
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AccessKey            string
+	SecretKey            string
+	CredsFilename        string
+	SharedConfigFilename string
+	Profile              string
+	Token                string
+	Region               string
+	MaxRetries           int
+
+	AssumeRoles               []AssumeRole
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
+
+	DefaultTags          map[string]interface{}
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+	ProtectTags          []ProtectTag
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	RdsEndpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	Insecure                 bool
+	AllowedRegions   []string
+	ForbiddenRegions []string
+
+	Endpoints map[string]string
+	Insecure  bool
+
+	HTTPProxy      string
+	NoProxy        string
+	CustomCABundle string
+
+	AuditLogPath string
+
+	IamPreflightResourceTypes []string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -118,6 +119,36 @@ type Config struct {
 	S3ForcePathStyle        bool
 }
 
+// ProtectTag is a tag that protects the resources carrying it from being
+// destroyed. An empty Value matches any value of the tag.
+type ProtectTag struct {
+	Key   string
+	Value string
+}
+
+// AssumeRole is a single hop of the chain of roles assumed before making API
+// calls. Each role is assumed with the credentials of the previous one.
+type AssumeRole struct {
+	RoleARN          string
+	SessionName      string
+	ExternalID       string
+	Policy           string
+	DurationSeconds  int
+	MFASerial        string
+	TokenCode        string
+	TokenCodeCommand string
+}
+
+// AssumeRoleWithWebIdentity is a role assumed with an OpenID Connect token
+// read from a file, instead of with the credentials from the environment.
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              string
+	SessionName          string
+	WebIdentityTokenFile string
+	Policy               string
+	DurationSeconds      int
+}
+
 type AWSClient struct {
 	cfconn                *cloudformation.CloudFormation
 	cloudfrontconn        *cloudfront.CloudFront
@@ -126,6 +157,7 @@ type AWSClient struct {
 	cloudwatchlogsconn    *cloudwatchlogs.CloudWatchLogs
 	cloudwatcheventsconn  *cloudwatchevents.CloudWatchEvents
 	cognitoconn           *cognitoidentity.CognitoIdentity
+	cognitoidpconn        *cognitoidentityprovider.CognitoIdentityProvider
 	configconn            *configservice.ConfigService
 	devicefarmconn        *devicefarm.DeviceFarm
 	dmsconn               *databasemigrationservice.DatabaseMigrationService
@@ -142,8 +174,10 @@ type AWSClient struct {
 	acmconn               *acm.ACM
 	apigateway            *apigateway.APIGateway
 	appautoscalingconn    *applicationautoscaling.ApplicationAutoScaling
+	athenaconn            *athena.Athena
 	autoscalingconn       *autoscaling.AutoScaling
 	s3conn                *s3.S3
+	servicecatalogconn    *servicecatalog.ServiceCatalog
 	sesConn               *ses.SES
 	simpledbconn          *simpledb.SimpleDB
 	sqsconn               *sqs.SQS
@@ -178,6 +212,13 @@ type AWSClient struct {
 	wafregionalconn       *wafregional.WAFRegional
 	iotconn               *iot.IoT
 	batchconn             *batch.Batch
+	defaultTags           map[string]interface{}
+	ignoreTagKeys         []string
+	ignoreTagKeyPrefixes  []string
+	protectTags           []ProtectTag
+	allowedRegions        []string
+	forbiddenRegions      []string
+	regionalClients       *awsRegionalClients
 }
 
 func (c *AWSClient) S3() *s3.S3 {
@@ -189,17 +230,11 @@ func (c *AWSClient) DynamoDB() *dynamodb.DynamoDB {
 }
 
 func (c *AWSClient) IsGovCloud() bool {
-	if c.region == "us-gov-west-1" {
-		return true
-	}
-	return false
+	return awsPartitionForRegion(c.region) == "aws-us-gov"
 }
 
 func (c *AWSClient) IsChinaCloud() bool {
-	if c.region == "cn-north-1" {
-		return true
-	}
-	return false
+	return awsPartitionForRegion(c.region) == "aws-cn"
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -216,10 +251,20 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	if err := c.ValidateRegionAllowed(); err != nil {
+		return nil, err
+	}
+
 	var client AWSClient
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagKeys = c.IgnoreTagKeys
+	client.ignoreTagKeyPrefixes = c.IgnoreTagKeyPrefixes
+	client.protectTags = c.ProtectTags
+	client.allowedRegions = c.AllowedRegions
+	client.forbiddenRegions = c.ForbiddenRegions
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -245,7 +290,7 @@ func (c *Config) Client() (interface{}, error) {
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
 		MaxRetries:       aws.Int(c.MaxRetries),
-		HTTPClient:       cleanhttp.DefaultClient(),
+		HTTPClient:       c.httpClient(),
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 	}
 
@@ -254,13 +299,6 @@ func (c *Config) Client() (interface{}, error) {
 		awsConfig.Logger = awsLogger{}
 	}
 
-	if c.Insecure {
-		transport := awsConfig.HTTPClient.Transport.(*http.Transport)
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true,
-		}
-	}
-
 	// Set up base session
 	sess, err := session.NewSession(awsConfig)
 	if err != nil {
@@ -273,35 +311,39 @@ func (c *Config) Client() (interface{}, error) {
 		sess.Handlers.UnmarshalError.PushFrontNamed(debugAuthFailure)
 	}
 
-	// This restriction should only be used for Route53 sessions.
-	// Other resources that have restrictions should allow the API to fail, rather
-	// than Terraform abstracting the region for the user. This can lead to breaking
-	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1")})
+	if sessionHandlersHook != nil {
+		sessionHandlersHook(&sess.Handlers)
+	}
+
+	var auditLog *awsAuditLog
+	if c.AuditLogPath != "" {
+		auditLog, err = newAwsAuditLog(c.AuditLogPath)
+		if err != nil {
+			return nil, err
+		}
+		auditLog.handlers(&sess.Handlers)
+	}
+
+	// All service clients share a single token bucket, so that they back off
+	// together once any of them gets throttled
+	retryTokenBucket := newAwsRetryTokenBucket()
+	retryTokenBucket.handlers(&sess.Handlers)
 
 	// Some services have user-configurable endpoints
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-
-	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
-
-	// These two services need to be set up early so we can check on AccountID
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(sess)
+	initServiceClients := func(client *AWSClient, region string) {
+		for _, svc := range awsServiceClients {
+			svc.init(client, sess.Copy(&aws.Config{
+				Region:   aws.String(region),
+				Endpoint: aws.String(c.Endpoints[svc.endpoint]),
+				Retryer:  newAwsRetryer(c.MaxRetries, awsServiceRetryRules[svc.endpoint], retryTokenBucket),
+			}))
+		}
+	}
+	initServiceClients(&client, c.Region)
+	client.regionalClients = &awsRegionalClients{
+		clients: make(map[string]*AWSClient),
+		init:    initServiceClients,
+	}
 
 	if !c.SkipCredsValidation {
 		err = c.ValidateCredentials(client.stsconn)
@@ -310,20 +352,30 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	var callerArn string
 	if !c.SkipRequestingAccountId {
-		partition, accountId, err := GetAccountInfo(client.iamconn, client.stsconn, cp.ProviderName)
+		partition, accountId, arn, err := getAccountInfo(client.iamconn, client.stsconn, cp.ProviderName)
 		if err == nil {
 			client.partition = partition
 			client.accountid = accountId
+			callerArn = arn
 		}
 	}
 
+	if auditLog != nil {
+		auditLog.setAccountID(client.accountid)
+	}
+
 	authErr := c.ValidateAccountId(client.accountid)
 	if authErr != nil {
 		return nil, authErr
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
+	if len(c.IamPreflightResourceTypes) > 0 {
+		if err := iamPreflightCheck(client.iamconn, callerArn, c.IamPreflightResourceTypes); err != nil {
+			return nil, err
+		}
+	}
 
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
@@ -336,87 +388,101 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.acmconn = acm.New(sess)
-	client.apigateway = apigateway.New(sess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(sess)
-	client.ecsconn = ecs.New(sess)
-	client.efsconn = efs.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(sess)
-	client.firehoseconn = firehose.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(sess)
-	client.lightsailconn = lightsail.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.sesConn = ses.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == kinesis.ErrCodeLimitExceededException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	return &client, nil
+}
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+// sessionHandlersHook, if set, is called with the handlers of the base session
+// before any service client is created from it. The acceptance tests use it
+// to record and replay API traffic.
+var sessionHandlersHook func(*request.Handlers)
+
+// awsServiceClients is the single table of service clients built by
+// Config.Client. Each entry names the key of the provider's `endpoints` block
+// that overrides the service endpoint, and initializes the matching AWSClient
+// field(s) from a session using that endpoint. The `endpoints` schema is
+// generated from this table, so adding a client here is all that's needed to
+// make its endpoint configurable.
+var awsServiceClients = []struct {
+	endpoint string
+	init     func(*AWSClient, *session.Session)
+}{
+	{"acm", func(c *AWSClient, s *session.Session) { c.acmconn = acm.New(s) }},
+	{"apigateway", func(c *AWSClient, s *session.Session) { c.apigateway = apigateway.New(s) }},
+	{"applicationautoscaling", func(c *AWSClient, s *session.Session) { c.appautoscalingconn = applicationautoscaling.New(s) }},
+	{"athena", func(c *AWSClient, s *session.Session) { c.athenaconn = athena.New(s) }},
+	{"autoscaling", func(c *AWSClient, s *session.Session) { c.autoscalingconn = autoscaling.New(s) }},
+	{"batch", func(c *AWSClient, s *session.Session) { c.batchconn = batch.New(s) }},
+	{"cloudformation", func(c *AWSClient, s *session.Session) { c.cfconn = cloudformation.New(s) }},
+	{"cloudfront", func(c *AWSClient, s *session.Session) { c.cloudfrontconn = cloudfront.New(s) }},
+	{"cloudtrail", func(c *AWSClient, s *session.Session) { c.cloudtrailconn = cloudtrail.New(s) }},
+	{"cloudwatch", func(c *AWSClient, s *session.Session) { c.cloudwatchconn = cloudwatch.New(s) }},
+	{"cloudwatchevents", func(c *AWSClient, s *session.Session) { c.cloudwatcheventsconn = cloudwatchevents.New(s) }},
+	{"cloudwatchlogs", func(c *AWSClient, s *session.Session) { c.cloudwatchlogsconn = cloudwatchlogs.New(s) }},
+	{"codebuild", func(c *AWSClient, s *session.Session) { c.codebuildconn = codebuild.New(s) }},
+	{"codecommit", func(c *AWSClient, s *session.Session) { c.codecommitconn = codecommit.New(s) }},
+	{"codedeploy", func(c *AWSClient, s *session.Session) { c.codedeployconn = codedeploy.New(s) }},
+	{"codepipeline", func(c *AWSClient, s *session.Session) { c.codepipelineconn = codepipeline.New(s) }},
+	{"cognitoidentity", func(c *AWSClient, s *session.Session) { c.cognitoconn = cognitoidentity.New(s) }},
+	{"cognitoidp", func(c *AWSClient, s *session.Session) { c.cognitoidpconn = cognitoidentityprovider.New(s) }},
+	{"configservice", func(c *AWSClient, s *session.Session) { c.configconn = configservice.New(s) }},
+	{"devicefarm", func(c *AWSClient, s *session.Session) { c.devicefarmconn = devicefarm.New(s) }},
+	{"dms", func(c *AWSClient, s *session.Session) { c.dmsconn = databasemigrationservice.New(s) }},
+	{"ds", func(c *AWSClient, s *session.Session) { c.dsconn = directoryservice.New(s) }},
+	{"dynamodb", func(c *AWSClient, s *session.Session) { c.dynamodbconn = dynamodb.New(s) }},
+	{"ec2", func(c *AWSClient, s *session.Session) { c.ec2conn = ec2.New(s) }},
+	{"ecr", func(c *AWSClient, s *session.Session) { c.ecrconn = ecr.New(s) }},
+	{"ecs", func(c *AWSClient, s *session.Session) { c.ecsconn = ecs.New(s) }},
+	{"efs", func(c *AWSClient, s *session.Session) { c.efsconn = efs.New(s) }},
+	{"elasticache", func(c *AWSClient, s *session.Session) { c.elasticacheconn = elasticache.New(s) }},
+	{"elasticbeanstalk", func(c *AWSClient, s *session.Session) { c.elasticbeanstalkconn = elasticbeanstalk.New(s) }},
+	{"elastictranscoder", func(c *AWSClient, s *session.Session) { c.elastictranscoderconn = elastictranscoder.New(s) }},
+	// The `elb` endpoint predates ELBv2 and has always been used for both.
+	{"elb", func(c *AWSClient, s *session.Session) {
+		c.elbconn = elb.New(s)
+		c.elbv2conn = elbv2.New(s)
+	}},
+	{"emr", func(c *AWSClient, s *session.Session) { c.emrconn = emr.New(s) }},
+	{"es", func(c *AWSClient, s *session.Session) { c.esconn = elasticsearch.New(s) }},
+	{"firehose", func(c *AWSClient, s *session.Session) { c.firehoseconn = firehose.New(s) }},
+	{"glacier", func(c *AWSClient, s *session.Session) { c.glacierconn = glacier.New(s) }},
+	{"iam", func(c *AWSClient, s *session.Session) { c.iamconn = iam.New(s) }},
+	{"inspector", func(c *AWSClient, s *session.Session) { c.inspectorconn = inspector.New(s) }},
+	{"iot", func(c *AWSClient, s *session.Session) { c.iotconn = iot.New(s) }},
+	{"kinesis", func(c *AWSClient, s *session.Session) { c.kinesisconn = kinesis.New(s) }},
+	{"kms", func(c *AWSClient, s *session.Session) { c.kmsconn = kms.New(s) }},
+	{"lambda", func(c *AWSClient, s *session.Session) { c.lambdaconn = lambda.New(s) }},
+	{"lightsail", func(c *AWSClient, s *session.Session) { c.lightsailconn = lightsail.New(s) }},
+	{"opsworks", func(c *AWSClient, s *session.Session) { c.opsworksconn = opsworks.New(s) }},
+	{"rds", func(c *AWSClient, s *session.Session) { c.rdsconn = rds.New(s) }},
+	{"redshift", func(c *AWSClient, s *session.Session) { c.redshiftconn = redshift.New(s) }},
+	// This restriction should only be used for Route53 sessions.
+	// Other resources that have restrictions should allow the API to fail, rather
+	// than Terraform abstracting the region for the user. This can lead to breaking
+	// changes if that resource is ever opened up to more regions.
+	{"route53", func(c *AWSClient, s *session.Session) {
+		c.r53conn = route53.New(s, &aws.Config{Region: aws.String("us-east-1")})
+	}},
+	{"s3", func(c *AWSClient, s *session.Session) { c.s3conn = s3.New(s) }},
+	{"sdb", func(c *AWSClient, s *session.Session) { c.simpledbconn = simpledb.New(s) }},
+	{"servicecatalog", func(c *AWSClient, s *session.Session) { c.servicecatalogconn = servicecatalog.New(s) }},
+	{"ses", func(c *AWSClient, s *session.Session) { c.sesConn = ses.New(s) }},
+	{"sns", func(c *AWSClient, s *session.Session) { c.snsconn = sns.New(s) }},
+	{"sqs", func(c *AWSClient, s *session.Session) { c.sqsconn = sqs.New(s) }},
+	{"ssm", func(c *AWSClient, s *session.Session) { c.ssmconn = ssm.New(s) }},
+	{"stepfunctions", func(c *AWSClient, s *session.Session) { c.sfnconn = sfn.New(s) }},
+	{"sts", func(c *AWSClient, s *session.Session) { c.stsconn = sts.New(s) }},
+	{"waf", func(c *AWSClient, s *session.Session) { c.wafconn = waf.New(s) }},
+	{"wafregional", func(c *AWSClient, s *session.Session) { c.wafregionalconn = wafregional.New(s) }},
+}
 
-	return &client, nil
+// endpointServiceNames returns the keys of the provider's `endpoints` block,
+// in the order they appear in awsServiceClients.
+func endpointServiceNames() []string {
+	names := make([]string, 0, len(awsServiceClients))
+	for _, svc := range awsServiceClients {
+		names = append(names, svc.endpoint)
+	}
+	return names
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -493,6 +559,18 @@ func (c *Config) ValidateAccountId(accountId string) error {
 	return nil
 }
 
+// ValidateRegionAllowed checks the region against the allowed_regions and
+// forbidden_regions glob patterns.
+func (c *Config) ValidateRegionAllowed() error {
+	if c.AllowedRegions == nil && c.ForbiddenRegions == nil {
+		return nil
+	}
+
+	log.Println("[INFO] Validating region")
+
+	return validateRegionAllowed(c.Region, c.AllowedRegions, c.ForbiddenRegions)
+}
+
 func GetSupportedEC2Platforms(conn *ec2.EC2) ([]string, error) {
 	attrName := "supported-platforms"
 
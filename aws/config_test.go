@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -41,6 +42,43 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestAwsServiceClients(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: awsCredentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var client AWSClient
+	seen := make(map[string]bool)
+	for _, svc := range awsServiceClients {
+		if seen[svc.endpoint] {
+			t.Fatalf("Duplicate endpoint %q", svc.endpoint)
+		}
+		seen[svc.endpoint] = true
+		svc.init(&client, sess)
+	}
+
+	// Every service client must be built from awsServiceClients so that its
+	// endpoint can be overridden.
+	v := reflect.ValueOf(client)
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.Type.Kind() != reflect.Ptr || !strings.HasPrefix(f.Type.Elem().PkgPath(), "github.com/aws/aws-sdk-go/service/") {
+			continue
+		}
+		if v.Field(i).IsNil() {
+			t.Errorf("AWSClient.%s is not initialized by awsServiceClients", f.Name)
+		}
+	}
+
+	if *client.r53conn.Config.Region != "us-east-1" {
+		t.Fatalf("Expected Route 53 client in us-east-1, got %q", *client.r53conn.Config.Region)
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// diffValidateFunc checks a constraint between several arguments of a
+// resource at plan time, when ValidateFunc only sees a single argument.
+type diffValidateFunc func(d *resourceDiff) error
+
+// validateDiff returns a CustomizeDiffFunc running all of the checks and
+// reporting every violated constraint at once, so the plan is aborted before
+// AWS rejects the configuration at apply time.
+func validateDiff(checks ...diffValidateFunc) customizeDiffFunc {
+	return func(d *resourceDiff, meta interface{}) error {
+		var errs *multierror.Error
+		for _, check := range checks {
+			if err := check(d); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// validateDiffOnCreate runs the checks only when the resource is created,
+// including when it is replaced. It's used for constraints that AWS only
+// enforces when creating a resource, and for arguments that may be
+// overwritten with the values read from AWS.
+func validateDiffOnCreate(checks ...diffValidateFunc) diffValidateFunc {
+	validate := validateDiff(checks...)
+	return func(d *resourceDiff) error {
+		if d.Id() != "" {
+			return nil
+		}
+		return validate(d, nil)
+	}
+}
+
+// diffValueSet reports whether an argument is set to a non-zero value. An
+// argument whose value isn't known until apply, e.g. because it is
+// interpolated from a resource that is yet to be created, is assumed to be
+// set, so that the checks don't fail on values they can't see yet.
+func diffValueSet(d *resourceDiff, key string) bool {
+	if !d.NewValueKnown(key) {
+		return true
+	}
+	_, ok := d.GetOk(key)
+	return ok
+}
+
+// diffValueConfigured reports whether an argument is given in the
+// configuration, as opposed to taking its default value.
+func diffValueConfigured(d *resourceDiff, key string) bool {
+	if d.config == nil {
+		return false
+	}
+	_, ok := d.config.Get(key)
+	return ok
+}
+
+// validateDiffConflictsWith checks that none of the other arguments is set
+// when the argument key is.
+func validateDiffConflictsWith(key string, others ...string) diffValidateFunc {
+	return func(d *resourceDiff) error {
+		if !diffValueSet(d, key) {
+			return nil
+		}
+		var errs *multierror.Error
+		for _, other := range others {
+			if diffValueSet(d, other) {
+				errs = multierror.Append(errs, fmt.Errorf("%q: conflicts with %s", other, key))
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// validateDiffRequiredWithout checks that all of the required arguments are
+// set, unless one of the alternatives is.
+func validateDiffRequiredWithout(required []string, alternatives ...string) diffValidateFunc {
+	return func(d *resourceDiff) error {
+		for _, alternative := range alternatives {
+			if diffValueSet(d, alternative) {
+				return nil
+			}
+		}
+		var errs *multierror.Error
+		for _, key := range required {
+			if !diffValueSet(d, key) {
+				errs = multierror.Append(errs, fmt.Errorf("%q: required field is not set unless one of %s is set",
+					key, strings.Join(alternatives, ", ")))
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// validateDiffAtLeastOneOf checks that at least one of the arguments is set.
+func validateDiffAtLeastOneOf(keys ...string) diffValidateFunc {
+	return func(d *resourceDiff) error {
+		for _, key := range keys {
+			if diffValueSet(d, key) {
+				return nil
+			}
+		}
+		return fmt.Errorf("One of %s must be set", strings.Join(keys, ", "))
+	}
+}
@@ -0,0 +1,387 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testAwsResourceConfig returns a resource configuration, in which
+// "${var.unknown}" is a value that isn't known until apply.
+func testAwsResourceConfig(t *testing.T, raw map[string]interface{}) *terraform.ResourceConfig {
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("Error creating config: %s", err)
+	}
+	err = rc.Interpolate(map[string]ast.Variable{
+		"var.unknown": {
+			Value: config.UnknownVariableValue,
+			Type:  ast.TypeUnknown,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error interpolating config: %s", err)
+	}
+
+	return terraform.NewResourceConfig(rc)
+}
+
+// testAwsResourceDiff plans the creation of a resource from a configuration.
+func testAwsResourceDiff(t *testing.T, resourceType string, raw map[string]interface{}) error {
+	_, err := PluginProvider().Diff(&terraform.InstanceInfo{Type: resourceType}, nil, testAwsResourceConfig(t, raw))
+	return err
+}
+
+func TestAwsResourceCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Type   string
+		Config map[string]interface{}
+		Error  string
+	}{
+		{
+			Name: "db instance",
+			Type: "aws_db_instance",
+			Config: map[string]interface{}{
+				"instance_class":    "db.t2.micro",
+				"allocated_storage": 10,
+				"engine":            "mysql",
+				"username":          "admin",
+				"password":          "password",
+			},
+		},
+		{
+			Name: "db instance without username",
+			Type: "aws_db_instance",
+			Config: map[string]interface{}{
+				"instance_class":    "db.t2.micro",
+				"allocated_storage": 10,
+				"engine":            "mysql",
+				"password":          "password",
+			},
+			Error: `"username": required field is not set`,
+		},
+		{
+			Name: "db instance replica",
+			Type: "aws_db_instance",
+			Config: map[string]interface{}{
+				"instance_class":      "db.t2.micro",
+				"replicate_source_db": "${var.unknown}",
+			},
+		},
+		{
+			Name: "db instance replica with username",
+			Type: "aws_db_instance",
+			Config: map[string]interface{}{
+				"instance_class":      "db.t2.micro",
+				"replicate_source_db": "source",
+				"username":            "admin",
+			},
+			Error: `"username": conflicts with replicate_source_db`,
+		},
+		{
+			Name: "fifo queue",
+			Type: "aws_sqs_queue",
+			Config: map[string]interface{}{
+				"name":                        "queue.fifo",
+				"fifo_queue":                  true,
+				"content_based_deduplication": true,
+			},
+		},
+		{
+			Name: "fifo queue without .fifo",
+			Type: "aws_sqs_queue",
+			Config: map[string]interface{}{
+				"name":       "queue",
+				"fifo_queue": true,
+			},
+			Error: `FIFO queue name should ends with ".fifo"`,
+		},
+		{
+			Name: "fifo queue with name_prefix",
+			Type: "aws_sqs_queue",
+			Config: map[string]interface{}{
+				"name_prefix": "queue",
+				"fifo_queue":  true,
+			},
+			Error: "name_prefix and generated names can't be used",
+		},
+		{
+			Name: "fifo queue with unknown name",
+			Type: "aws_sqs_queue",
+			Config: map[string]interface{}{
+				"name":       "${var.unknown}",
+				"fifo_queue": true,
+			},
+		},
+		{
+			Name: "standard queue with .fifo",
+			Type: "aws_sqs_queue",
+			Config: map[string]interface{}{
+				"name": "queue.fifo",
+			},
+			Error: "only allowed with fifo_queue = true",
+		},
+		{
+			Name: "standard queue with content based deduplication",
+			Type: "aws_sqs_queue",
+			Config: map[string]interface{}{
+				"content_based_deduplication": true,
+			},
+			Error: "Content based deduplication can only be set with FIFO queues",
+		},
+		{
+			Name: "target group health check timeout",
+			Type: "aws_alb_target_group",
+			Config: map[string]interface{}{
+				"port":     80,
+				"protocol": "HTTP",
+				"vpc_id":   "vpc-12345678",
+				"health_check": []interface{}{
+					map[string]interface{}{
+						"interval": 10,
+						"timeout":  10,
+					},
+				},
+			},
+			Error: "health_check: timeout (10) must be smaller than the interval (10)",
+		},
+		{
+			Name: "TCP target group with HTTPS health check",
+			Type: "aws_alb_target_group",
+			Config: map[string]interface{}{
+				"port":     80,
+				"protocol": "TCP",
+				"vpc_id":   "vpc-12345678",
+				"health_check": []interface{}{
+					map[string]interface{}{
+						"protocol": "HTTPS",
+						"path":     "/health",
+					},
+				},
+			},
+			Error: "health_check: HTTPS health checks can't be used with TCP target groups",
+		},
+		{
+			Name: "TCP target group with health check matcher",
+			Type: "aws_alb_target_group",
+			Config: map[string]interface{}{
+				"port":     80,
+				"protocol": "TCP",
+				"vpc_id":   "vpc-12345678",
+				"health_check": []interface{}{
+					map[string]interface{}{
+						"protocol": "TCP",
+						"matcher":  "200-299",
+					},
+				},
+			},
+			Error: `health_check: "matcher" can't be set for TCP target groups`,
+		},
+		{
+			Name: "HTTP target group with HTTPS health check",
+			Type: "aws_alb_target_group",
+			Config: map[string]interface{}{
+				"port":     80,
+				"protocol": "HTTP",
+				"vpc_id":   "vpc-12345678",
+				"health_check": []interface{}{
+					map[string]interface{}{
+						"protocol": "HTTPS",
+						"path":     "/health",
+					},
+				},
+			},
+		},
+		{
+			Name: "HTTPS listener without certificate",
+			Type: "aws_alb_listener",
+			Config: map[string]interface{}{
+				"load_balancer_arn": "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb/0123456789abcdef",
+				"port":              443,
+				"protocol":          "https",
+				"default_action": []interface{}{
+					map[string]interface{}{
+						"target_group_arn": "${var.unknown}",
+						"type":             "forward",
+					},
+				},
+			},
+			Error: `"certificate_arn": required field is not set for HTTPS listeners`,
+		},
+		{
+			Name: "autoscaling group sizes",
+			Type: "aws_autoscaling_group",
+			Config: map[string]interface{}{
+				"min_size":         2,
+				"max_size":         4,
+				"desired_capacity": 5,
+			},
+			Error: "desired_capacity (5) must be between min_size (2) and max_size (4)",
+		},
+		{
+			Name: "security group rule without source",
+			Type: "aws_security_group_rule",
+			Config: map[string]interface{}{
+				"type":              "ingress",
+				"from_port":         22,
+				"to_port":           22,
+				"protocol":          "tcp",
+				"security_group_id": "sg-12345678",
+			},
+			Error: "One of cidr_blocks, ipv6_cidr_blocks, prefix_list_ids, source_security_group_id, self must be set",
+		},
+		{
+			Name: "security group rule with unknown source",
+			Type: "aws_security_group_rule",
+			Config: map[string]interface{}{
+				"type":                     "ingress",
+				"from_port":                22,
+				"to_port":                  22,
+				"protocol":                 "tcp",
+				"security_group_id":        "sg-12345678",
+				"source_security_group_id": "${var.unknown}",
+			},
+		},
+		{
+			Name: "user pool with MFA",
+			Type: "aws_cognito_user_pool",
+			Config: map[string]interface{}{
+				"name":              "pool",
+				"mfa_configuration": "ON",
+				"sms_configuration": []interface{}{
+					map[string]interface{}{
+						"external_id":    "id",
+						"sns_caller_arn": "${var.unknown}",
+					},
+				},
+			},
+		},
+		{
+			Name: "user pool with MFA without SMS configuration",
+			Type: "aws_cognito_user_pool",
+			Config: map[string]interface{}{
+				"name":              "pool",
+				"mfa_configuration": "OPTIONAL",
+			},
+			Error: `"sms_configuration": required field is not set when mfa_configuration is OPTIONAL`,
+		},
+		{
+			Name: "identity pool rules-based role mapping",
+			Type: "aws_cognito_identity_pool_roles_attachment",
+			Config: map[string]interface{}{
+				"identity_pool_id": "us-east-1:12345678-dead-beef-cafe-123456790ab",
+				"roles": map[string]interface{}{
+					"authenticated": "arn:aws:iam::123456789012:role/auth",
+				},
+				"role_mapping": []interface{}{
+					map[string]interface{}{
+						"identity_provider":         "graph.facebook.com",
+						"ambiguous_role_resolution": "AuthenticatedRole",
+						"type":                      "Rules",
+						"mapping_rule": []interface{}{
+							map[string]interface{}{
+								"claim":      "isAdmin",
+								"match_type": "Equals",
+								"role_arn":   "arn:aws:iam::123456789012:role/admin",
+								"value":      "paid",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "identity pool rules-based role mapping without rules",
+			Type: "aws_cognito_identity_pool_roles_attachment",
+			Config: map[string]interface{}{
+				"identity_pool_id": "us-east-1:12345678-dead-beef-cafe-123456790ab",
+				"roles": map[string]interface{}{
+					"authenticated": "arn:aws:iam::123456789012:role/auth",
+				},
+				"role_mapping": []interface{}{
+					map[string]interface{}{
+						"identity_provider":         "graph.facebook.com",
+						"ambiguous_role_resolution": "Deny",
+						"type":                      "Rules",
+					},
+				},
+			},
+			Error: `role_mapping "graph.facebook.com": mapping_rule is required for type Rules`,
+		},
+		{
+			Name: "identity pool token-based role mapping with rules",
+			Type: "aws_cognito_identity_pool_roles_attachment",
+			Config: map[string]interface{}{
+				"identity_pool_id": "us-east-1:12345678-dead-beef-cafe-123456790ab",
+				"roles": map[string]interface{}{
+					"authenticated": "arn:aws:iam::123456789012:role/auth",
+				},
+				"role_mapping": []interface{}{
+					map[string]interface{}{
+						"identity_provider":         "graph.facebook.com",
+						"ambiguous_role_resolution": "Deny",
+						"type":                      "Token",
+						"mapping_rule": []interface{}{
+							map[string]interface{}{
+								"claim":      "isAdmin",
+								"match_type": "Equals",
+								"role_arn":   "arn:aws:iam::123456789012:role/admin",
+								"value":      "paid",
+							},
+						},
+					},
+				},
+			},
+			Error: `role_mapping "graph.facebook.com": mapping_rule can't be set for type Token`,
+		},
+		{
+			Name: "athena database with KMS encryption",
+			Type: "aws_athena_database",
+			Config: map[string]interface{}{
+				"name":   "db",
+				"bucket": "bucket",
+				"encryption_configuration": []interface{}{
+					map[string]interface{}{
+						"encryption_option": "SSE_KMS",
+						"kms_key":           "${var.unknown}",
+					},
+				},
+			},
+		},
+		{
+			Name: "athena database with KMS encryption without key",
+			Type: "aws_athena_database",
+			Config: map[string]interface{}{
+				"name":   "db",
+				"bucket": "bucket",
+				"encryption_configuration": []interface{}{
+					map[string]interface{}{
+						"encryption_option": "CSE_KMS",
+					},
+				},
+			},
+			Error: `"encryption_configuration.0.kms_key": required field is not set when encryption_option is CSE_KMS`,
+		},
+	}
+
+	for _, tc := range cases {
+		err := testAwsResourceDiff(t, tc.Type, tc.Config)
+		if tc.Error == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.Name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: expected error containing %q", tc.Name, tc.Error)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.Error) {
+			t.Errorf("%s: expected error containing %q, got: %s", tc.Name, tc.Error, err)
+		}
+	}
+}
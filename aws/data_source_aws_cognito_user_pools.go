@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCognitoUserPools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCognitoUserPoolsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCognitoUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.cognitoidpconn
+
+	name := d.Get("name").(string)
+	var ids, arns []string
+
+	// User pool names aren't unique, so every pool with the name is returned.
+	params := &cognitoidentityprovider.ListUserPoolsInput{
+		MaxResults: aws.Int64(60),
+	}
+	for {
+		log.Printf("[DEBUG] Listing Cognito User Pools: %s", params)
+		resp, err := conn.ListUserPools(params)
+		if err != nil {
+			return errwrap.Wrapf("Error listing Cognito User Pools: {{err}}", err)
+		}
+
+		for _, pool := range resp.UserPools {
+			if aws.StringValue(pool.Name) == name {
+				ids = append(ids, *pool.Id)
+				arns = append(arns, cognitoUserPoolArn(client, *pool.Id))
+			}
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		params.NextToken = resp.NextToken
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("No Cognito User Pool found with name: %s", name)
+	}
+
+	d.SetId(name)
+	d.Set("ids", ids)
+	d.Set("arns", arns)
+
+	return nil
+}
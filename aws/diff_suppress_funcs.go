@@ -60,6 +60,19 @@ func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) boo
 	return jsonBytesEqual(ob.Bytes(), nb.Bytes())
 }
 
+// suppressEquivalentIamRoleNameArnDiffs suppresses diffs between the name and
+// the ARN of the same IAM role, for arguments that accept either.
+func suppressEquivalentIamRoleNameArnDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return arnNameEquivalent(old, new, "iam", "role")
+}
+
+// suppressEquivalentLambdaFunctionNameArnDiffs suppresses diffs between the
+// name and the ARN of the same Lambda function, for arguments that accept
+// either.
+func suppressEquivalentLambdaFunctionNameArnDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return arnNameEquivalent(old, new, "lambda", "function")
+}
+
 func suppressOpenIdURL(k, old, new string, d *schema.ResourceData) bool {
 	oldUrl, err := url.Parse(old)
 	if err != nil {
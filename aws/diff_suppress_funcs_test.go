@@ -29,3 +29,37 @@ func TestSuppressEquivalentJsonDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 		t.Errorf("Expected suppressEquivalentJsonDiffs to return false for %s == %s", noWhitespaceDiff, whitespaceDiff)
 	}
 }
+
+func TestSuppressEquivalentIamRoleNameArnDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	cases := []struct {
+		Old        string
+		New        string
+		Equivalent bool
+	}{
+		{"arn:aws:iam::123456789012:role/ecs", "ecs", true},
+		{"arn:aws:iam::123456789012:role/service/ecs", "ecs", true},
+		{"ecs", "arn:aws:iam::123456789012:role/ecs", true},
+		{"arn:aws:iam::123456789012:role/ecs", "other", false},
+		{"arn:aws:iam::123456789012:user/ecs", "ecs", false},
+		{"", "ecs", false},
+	}
+
+	for _, tc := range cases {
+		if equivalent := suppressEquivalentIamRoleNameArnDiffs("", tc.Old, tc.New, d); equivalent != tc.Equivalent {
+			t.Errorf("%q and %q: expected equivalent %t, got %t", tc.Old, tc.New, tc.Equivalent, equivalent)
+		}
+	}
+}
+
+func TestSuppressEquivalentLambdaFunctionNameArnDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	if !suppressEquivalentLambdaFunctionNameArnDiffs("", "arn:aws:lambda:us-east-1:123456789012:function:fn", "fn", d) {
+		t.Error("Expected a function name and its ARN to be equivalent")
+	}
+	if suppressEquivalentLambdaFunctionNameArnDiffs("", "arn:aws:lambda:us-east-1:123456789012:function:fn:1", "fn", d) {
+		t.Error("Expected a function name and the ARN of a version to differ")
+	}
+}
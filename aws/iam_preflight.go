@@ -0,0 +1,393 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// awsIamPreflightActions maps resource types to the IAM actions needed to
+// create, read, update and destroy them. It is used by the provider's
+// iam_preflight check; resource types missing here aren't checked. It has to
+// be maintained by hand since the SDK doesn't record which API calls each
+// resource makes.
+var awsIamPreflightActions = map[string][]string{
+	"aws_autoscaling_group": {
+		"autoscaling:CreateAutoScalingGroup",
+		"autoscaling:DeleteAutoScalingGroup",
+		"autoscaling:DescribeAutoScalingGroups",
+		"autoscaling:UpdateAutoScalingGroup",
+	},
+	"aws_cloudwatch_log_group": {
+		"logs:CreateLogGroup",
+		"logs:DeleteLogGroup",
+		"logs:DescribeLogGroups",
+		"logs:PutRetentionPolicy",
+	},
+	"aws_cloudwatch_metric_alarm": {
+		"cloudwatch:DeleteAlarms",
+		"cloudwatch:DescribeAlarms",
+		"cloudwatch:PutMetricAlarm",
+	},
+	"aws_db_instance": {
+		"rds:AddTagsToResource",
+		"rds:CreateDBInstance",
+		"rds:DeleteDBInstance",
+		"rds:DescribeDBInstances",
+		"rds:ListTagsForResource",
+		"rds:ModifyDBInstance",
+	},
+	"aws_db_subnet_group": {
+		"rds:CreateDBSubnetGroup",
+		"rds:DeleteDBSubnetGroup",
+		"rds:DescribeDBSubnetGroups",
+		"rds:ModifyDBSubnetGroup",
+	},
+	"aws_dynamodb_table": {
+		"dynamodb:CreateTable",
+		"dynamodb:DeleteTable",
+		"dynamodb:DescribeTable",
+		"dynamodb:TagResource",
+		"dynamodb:UpdateTable",
+	},
+	"aws_ecr_repository": {
+		"ecr:CreateRepository",
+		"ecr:DeleteRepository",
+		"ecr:DescribeRepositories",
+	},
+	"aws_ecs_cluster": {
+		"ecs:CreateCluster",
+		"ecs:DeleteCluster",
+		"ecs:DescribeClusters",
+	},
+	"aws_ecs_service": {
+		"ecs:CreateService",
+		"ecs:DeleteService",
+		"ecs:DescribeServices",
+		"ecs:UpdateService",
+	},
+	"aws_ecs_task_definition": {
+		"ecs:DeregisterTaskDefinition",
+		"ecs:DescribeTaskDefinition",
+		"ecs:RegisterTaskDefinition",
+	},
+	"aws_eip": {
+		"ec2:AllocateAddress",
+		"ec2:AssociateAddress",
+		"ec2:DescribeAddresses",
+		"ec2:DisassociateAddress",
+		"ec2:ReleaseAddress",
+	},
+	"aws_elb": {
+		"elasticloadbalancing:AddTags",
+		"elasticloadbalancing:CreateLoadBalancer",
+		"elasticloadbalancing:DeleteLoadBalancer",
+		"elasticloadbalancing:DescribeLoadBalancers",
+		"elasticloadbalancing:ModifyLoadBalancerAttributes",
+	},
+	"aws_iam_instance_profile": {
+		"iam:AddRoleToInstanceProfile",
+		"iam:CreateInstanceProfile",
+		"iam:DeleteInstanceProfile",
+		"iam:GetInstanceProfile",
+		"iam:RemoveRoleFromInstanceProfile",
+	},
+	"aws_iam_policy": {
+		"iam:CreatePolicy",
+		"iam:CreatePolicyVersion",
+		"iam:DeletePolicy",
+		"iam:DeletePolicyVersion",
+		"iam:GetPolicy",
+		"iam:GetPolicyVersion",
+		"iam:ListPolicyVersions",
+	},
+	"aws_iam_role": {
+		"iam:CreateRole",
+		"iam:DeleteRole",
+		"iam:GetRole",
+		"iam:ListInstanceProfilesForRole",
+		"iam:UpdateAssumeRolePolicy",
+	},
+	"aws_iam_role_policy": {
+		"iam:DeleteRolePolicy",
+		"iam:GetRolePolicy",
+		"iam:PutRolePolicy",
+	},
+	"aws_iam_role_policy_attachment": {
+		"iam:AttachRolePolicy",
+		"iam:DetachRolePolicy",
+		"iam:ListAttachedRolePolicies",
+	},
+	"aws_instance": {
+		"ec2:CreateTags",
+		"ec2:DescribeInstanceAttribute",
+		"ec2:DescribeInstances",
+		"ec2:DescribeVolumes",
+		"ec2:ModifyInstanceAttribute",
+		"ec2:RunInstances",
+		"ec2:TerminateInstances",
+	},
+	"aws_internet_gateway": {
+		"ec2:AttachInternetGateway",
+		"ec2:CreateInternetGateway",
+		"ec2:CreateTags",
+		"ec2:DeleteInternetGateway",
+		"ec2:DescribeInternetGateways",
+		"ec2:DetachInternetGateway",
+	},
+	"aws_kms_key": {
+		"kms:CreateKey",
+		"kms:DescribeKey",
+		"kms:GetKeyPolicy",
+		"kms:GetKeyRotationStatus",
+		"kms:ScheduleKeyDeletion",
+	},
+	"aws_lambda_function": {
+		"lambda:CreateFunction",
+		"lambda:DeleteFunction",
+		"lambda:GetFunction",
+		"lambda:UpdateFunctionCode",
+		"lambda:UpdateFunctionConfiguration",
+	},
+	"aws_launch_configuration": {
+		"autoscaling:CreateLaunchConfiguration",
+		"autoscaling:DeleteLaunchConfiguration",
+		"autoscaling:DescribeLaunchConfigurations",
+	},
+	"aws_nat_gateway": {
+		"ec2:CreateNatGateway",
+		"ec2:DeleteNatGateway",
+		"ec2:DescribeNatGateways",
+	},
+	"aws_route": {
+		"ec2:CreateRoute",
+		"ec2:DeleteRoute",
+		"ec2:DescribeRouteTables",
+		"ec2:ReplaceRoute",
+	},
+	"aws_route53_record": {
+		"route53:ChangeResourceRecordSets",
+		"route53:GetChange",
+		"route53:GetHostedZone",
+		"route53:ListResourceRecordSets",
+	},
+	"aws_route53_zone": {
+		"route53:ChangeTagsForResource",
+		"route53:CreateHostedZone",
+		"route53:DeleteHostedZone",
+		"route53:GetChange",
+		"route53:GetHostedZone",
+	},
+	"aws_route_table": {
+		"ec2:CreateRouteTable",
+		"ec2:CreateTags",
+		"ec2:DeleteRouteTable",
+		"ec2:DescribeRouteTables",
+	},
+	"aws_route_table_association": {
+		"ec2:AssociateRouteTable",
+		"ec2:DescribeRouteTables",
+		"ec2:DisassociateRouteTable",
+		"ec2:ReplaceRouteTableAssociation",
+	},
+	"aws_s3_bucket": {
+		"s3:CreateBucket",
+		"s3:DeleteBucket",
+		"s3:GetBucketLocation",
+		"s3:GetBucketPolicy",
+		"s3:GetBucketTagging",
+		"s3:GetBucketVersioning",
+		"s3:ListBucket",
+		"s3:PutBucketTagging",
+	},
+	"aws_s3_bucket_object": {
+		"s3:DeleteObject",
+		"s3:GetObject",
+		"s3:PutObject",
+	},
+	"aws_s3_bucket_policy": {
+		"s3:DeleteBucketPolicy",
+		"s3:GetBucketPolicy",
+		"s3:PutBucketPolicy",
+	},
+	"aws_security_group": {
+		"ec2:AuthorizeSecurityGroupEgress",
+		"ec2:AuthorizeSecurityGroupIngress",
+		"ec2:CreateSecurityGroup",
+		"ec2:CreateTags",
+		"ec2:DeleteSecurityGroup",
+		"ec2:DescribeSecurityGroups",
+		"ec2:RevokeSecurityGroupEgress",
+		"ec2:RevokeSecurityGroupIngress",
+	},
+	"aws_security_group_rule": {
+		"ec2:AuthorizeSecurityGroupEgress",
+		"ec2:AuthorizeSecurityGroupIngress",
+		"ec2:DescribeSecurityGroups",
+		"ec2:RevokeSecurityGroupEgress",
+		"ec2:RevokeSecurityGroupIngress",
+	},
+	"aws_sns_topic": {
+		"sns:CreateTopic",
+		"sns:DeleteTopic",
+		"sns:GetTopicAttributes",
+		"sns:SetTopicAttributes",
+	},
+	"aws_sns_topic_subscription": {
+		"sns:GetSubscriptionAttributes",
+		"sns:Subscribe",
+		"sns:Unsubscribe",
+	},
+	"aws_sqs_queue": {
+		"sqs:CreateQueue",
+		"sqs:DeleteQueue",
+		"sqs:GetQueueAttributes",
+		"sqs:GetQueueUrl",
+		"sqs:SetQueueAttributes",
+	},
+	"aws_subnet": {
+		"ec2:CreateSubnet",
+		"ec2:CreateTags",
+		"ec2:DeleteSubnet",
+		"ec2:DescribeSubnets",
+		"ec2:ModifySubnetAttribute",
+	},
+	"aws_vpc": {
+		"ec2:CreateTags",
+		"ec2:CreateVpc",
+		"ec2:DeleteVpc",
+		"ec2:DescribeVpcAttribute",
+		"ec2:DescribeVpcs",
+		"ec2:ModifyVpcAttribute",
+	},
+}
+
+// iamPreflightActions returns the sorted IAM actions needed for the given
+// resource types, and the types for which they aren't known.
+func iamPreflightActions(resourceTypes []string) ([]string, []string) {
+	set := make(map[string]bool)
+	var unknown []string
+	for _, t := range resourceTypes {
+		actions, ok := awsIamPreflightActions[t]
+		if !ok {
+			unknown = append(unknown, t)
+			continue
+		}
+		for _, action := range actions {
+			set[action] = true
+		}
+	}
+
+	actions := make([]string, 0, len(set))
+	for action := range set {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	return actions, unknown
+}
+
+// iamPreflightCheck simulates the IAM policies of the caller for all actions
+// needed by the given resource types, and returns an error listing every
+// action that isn't allowed. The caller's ARN is the one GetAccountInfo found;
+// without it, nothing is checked.
+func iamPreflightCheck(iamconn *iam.IAM, callerArn string, resourceTypes []string) error {
+	actions, unknown := iamPreflightActions(resourceTypes)
+	if len(unknown) > 0 {
+		log.Printf("[WARN] IAM preflight check doesn't know the actions needed for: %s", strings.Join(unknown, ", "))
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+
+	principal, err := iamPreflightPrincipalArn(iamconn, callerArn)
+	if err != nil {
+		return fmt.Errorf("Error running IAM preflight check: %s", err)
+	}
+	if principal == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Simulating %d IAM actions for %s", len(actions), principal)
+	var denied []string
+	err = iamconn.SimulatePrincipalPolicyPages(&iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principal),
+		ActionNames:     aws.StringSlice(actions),
+	}, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		for _, result := range page.EvaluationResults {
+			if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, fmt.Sprintf("%s (%s)",
+					aws.StringValue(result.EvalActionName), aws.StringValue(result.EvalDecision)))
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error simulating IAM policies of %s: %s", principal, err)
+	}
+
+	if len(denied) > 0 {
+		return fmt.Errorf("IAM preflight check failed: %s is not allowed the following actions:\n\n* %s",
+			principal, strings.Join(denied, "\n* "))
+	}
+
+	return nil
+}
+
+// iamPreflightPrincipalArn returns the ARN of the IAM user or role of the
+// caller, as accepted by SimulatePrincipalPolicy. The ARNs of an assumed role
+// session and of an instance profile are resolved to the ARN of their role,
+// including its path. For callers whose policies can't be simulated, like the
+// root user and federated users, it logs a warning and returns an empty ARN.
+func iamPreflightPrincipalArn(iamconn *iam.IAM, arn string) (string, error) {
+	if arn == "" {
+		log.Printf("[WARN] Skipping IAM preflight check, the caller's ARN is unknown")
+		return "", nil
+	}
+
+	// arn:PARTITION:iam::ACCOUNT:user/USER
+	// arn:PARTITION:iam::ACCOUNT:instance-profile/PROFILE
+	// arn:PARTITION:iam::ACCOUNT:root
+	// arn:PARTITION:sts::ACCOUNT:assumed-role/ROLE/SESSION
+	// arn:PARTITION:sts::ACCOUNT:federated-user/USER
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 {
+		log.Printf("[WARN] Skipping IAM preflight check, unexpected caller ARN: %s", arn)
+		return "", nil
+	}
+	if parts[2] == "iam" && strings.HasPrefix(parts[5], "instance-profile/") {
+		name := parts[5][strings.LastIndex(parts[5], "/")+1:]
+		profile, err := iamconn.GetInstanceProfile(&iam.GetInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+		})
+		if err != nil {
+			return "", fmt.Errorf("Error getting instance profile %s: %s", name, err)
+		}
+		if len(profile.InstanceProfile.Roles) == 0 {
+			log.Printf("[WARN] Skipping IAM preflight check, instance profile %s has no role", arn)
+			return "", nil
+		}
+		return aws.StringValue(profile.InstanceProfile.Roles[0].Arn), nil
+	}
+	if parts[2] == "iam" && parts[5] != "root" {
+		return arn, nil
+	}
+	resource := strings.Split(parts[5], "/")
+	if parts[2] != "sts" || len(resource) != 3 || resource[0] != "assumed-role" {
+		log.Printf("[WARN] Skipping IAM preflight check, policies of %s can't be simulated", arn)
+		return "", nil
+	}
+
+	role, err := iamconn.GetRole(&iam.GetRoleInput{
+		RoleName: aws.String(resource[1]),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error getting role %s of %s: %s", resource[1], arn, err)
+	}
+
+	return aws.StringValue(role.Role.Arn), nil
+}
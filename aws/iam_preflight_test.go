@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAwsIamPreflightActionsResourceTypes(t *testing.T) {
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for name := range awsIamPreflightActions {
+		if _, ok := resources[name]; !ok {
+			t.Errorf("IAM preflight actions for unknown resource type %q", name)
+		}
+	}
+}
+
+func TestIamPreflightActions(t *testing.T) {
+	actions, unknown := iamPreflightActions([]string{"aws_route_table", "aws_subnet", "aws_unknown"})
+
+	expected := []string{
+		"ec2:CreateRouteTable",
+		"ec2:CreateSubnet",
+		"ec2:CreateTags",
+		"ec2:DeleteRouteTable",
+		"ec2:DeleteSubnet",
+		"ec2:DescribeRouteTables",
+		"ec2:DescribeSubnets",
+		"ec2:ModifySubnetAttribute",
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("Expected actions %q, got %q", expected, actions)
+	}
+	if !reflect.DeepEqual(unknown, []string{"aws_unknown"}) {
+		t.Fatalf("Expected unknown resource types [aws_unknown], got %q", unknown)
+	}
+}
+
+func TestIamPreflightCheck(t *testing.T) {
+	iamEndpoints := []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=GetRole&RoleName=deploy&Version=2010-05-08"},
+			Response: &awsMockResponse{200, iamResponse_GetRole_deploy, "text/xml"},
+		},
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=SimulatePrincipalPolicy" +
+				"&ActionNames.member.1=sns%3ACreateTopic&ActionNames.member.2=sns%3ADeleteTopic" +
+				"&ActionNames.member.3=sns%3AGetTopicAttributes&ActionNames.member.4=sns%3ASetTopicAttributes" +
+				"&PolicySourceArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Fci%2Fdeploy&Version=2010-05-08"},
+			Response: &awsMockResponse{200, iamResponse_SimulatePrincipalPolicy_sns, "text/xml"},
+		},
+	}
+	closeIam, iamSess, err := getMockedAwsApiSession("IAM", iamEndpoints)
+	defer closeIam()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = iamPreflightCheck(iam.New(iamSess), "arn:aws:sts::123456789012:assumed-role/deploy/terraform", []string{"aws_sns_topic"})
+	if err == nil {
+		t.Fatal("Expected the IAM preflight check to fail")
+	}
+
+	for _, expected := range []string{
+		"arn:aws:iam::123456789012:role/ci/deploy",
+		"sns:DeleteTopic (implicitDeny)",
+		"sns:SetTopicAttributes (explicitDeny)",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("Expected error to contain %q, got: %s", expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), "sns:CreateTopic") {
+		t.Fatalf("Expected error not to mention allowed actions, got: %s", err)
+	}
+}
+
+func TestIamPreflightPrincipalArn_instanceProfile(t *testing.T) {
+	iamEndpoints := []*awsMockEndpoint{
+		{
+			Request:  &awsMockRequest{"POST", "/", "Action=GetInstanceProfile&InstanceProfileName=deploy&Version=2010-05-08"},
+			Response: &awsMockResponse{200, iamResponse_GetInstanceProfile_deploy, "text/xml"},
+		},
+	}
+	closeIam, iamSess, err := getMockedAwsApiSession("IAM", iamEndpoints)
+	defer closeIam()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	principal, err := iamPreflightPrincipalArn(iam.New(iamSess), "arn:aws:iam::123456789012:instance-profile/ci/deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "arn:aws:iam::123456789012:role/ci/deploy"; principal != expected {
+		t.Fatalf("Expected principal %s, got %s", expected, principal)
+	}
+}
+
+func TestIamPreflightPrincipalArn_unsupported(t *testing.T) {
+	for _, arn := range []string{
+		"",
+		"arn:aws:iam::123456789012:root",
+		"arn:aws:sts::123456789012:federated-user/alice",
+	} {
+		principal, err := iamPreflightPrincipalArn(nil, arn)
+		if err != nil {
+			t.Fatalf("%q: expected the check to be skipped, got: %s", arn, err)
+		}
+		if principal != "" {
+			t.Fatalf("%q: expected no principal, got %s", arn, principal)
+		}
+	}
+}
+
+const iamResponse_GetRole_deploy = `<GetRoleResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetRoleResult>
+    <Role>
+      <Path>/ci/</Path>
+      <Arn>arn:aws:iam::123456789012:role/ci/deploy</Arn>
+      <RoleName>deploy</RoleName>
+      <AssumeRolePolicyDocument>%7B%7D</AssumeRolePolicyDocument>
+      <CreateDate>2017-09-01T00:00:00Z</CreateDate>
+      <RoleId>AROAEXAMPLE</RoleId>
+    </Role>
+  </GetRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetRoleResponse>`
+
+const iamResponse_GetInstanceProfile_deploy = `<GetInstanceProfileResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetInstanceProfileResult>
+    <InstanceProfile>
+      <InstanceProfileId>AIPAEXAMPLE</InstanceProfileId>
+      <Roles>
+        <member>
+          <Path>/ci/</Path>
+          <Arn>arn:aws:iam::123456789012:role/ci/deploy</Arn>
+          <RoleName>deploy</RoleName>
+          <AssumeRolePolicyDocument>%7B%7D</AssumeRolePolicyDocument>
+          <CreateDate>2017-09-01T00:00:00Z</CreateDate>
+          <RoleId>AROAEXAMPLE</RoleId>
+        </member>
+      </Roles>
+      <InstanceProfileName>deploy</InstanceProfileName>
+      <Path>/ci/</Path>
+      <Arn>arn:aws:iam::123456789012:instance-profile/ci/deploy</Arn>
+      <CreateDate>2017-09-01T00:00:00Z</CreateDate>
+    </InstanceProfile>
+  </GetInstanceProfileResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetInstanceProfileResponse>`
+
+const iamResponse_SimulatePrincipalPolicy_sns = `<SimulatePrincipalPolicyResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <SimulatePrincipalPolicyResult>
+    <IsTruncated>false</IsTruncated>
+    <EvaluationResults>
+      <member>
+        <EvalActionName>sns:CreateTopic</EvalActionName>
+        <EvalResourceName>*</EvalResourceName>
+        <EvalDecision>allowed</EvalDecision>
+      </member>
+      <member>
+        <EvalActionName>sns:DeleteTopic</EvalActionName>
+        <EvalResourceName>*</EvalResourceName>
+        <EvalDecision>implicitDeny</EvalDecision>
+      </member>
+      <member>
+        <EvalActionName>sns:GetTopicAttributes</EvalActionName>
+        <EvalResourceName>*</EvalResourceName>
+        <EvalDecision>allowed</EvalDecision>
+      </member>
+      <member>
+        <EvalActionName>sns:SetTopicAttributes</EvalActionName>
+        <EvalResourceName>*</EvalResourceName>
+        <EvalDecision>explicitDeny</EvalDecision>
+      </member>
+    </EvaluationResults>
+  </SimulatePrincipalPolicyResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</SimulatePrincipalPolicyResponse>`
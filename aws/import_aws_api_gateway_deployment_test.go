@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayDeployment_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_deployment.test"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayDeploymentConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "rest_api_id", "id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDeploymentDestroy,
+		Steps:        steps,
+	})
+}
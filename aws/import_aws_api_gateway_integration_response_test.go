@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayIntegrationResponse_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_integration_response.test"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayIntegrationResponseConfig,
+		},
+
+		{
+			ResourceName:            resourceName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"response_parameters_in_json"},
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "rest_api_id", "resource_id", "http_method", "status_code")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayIntegrationResponseDestroy,
+		Steps:        steps,
+	})
+}
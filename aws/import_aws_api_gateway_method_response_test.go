@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayMethodResponse_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_method_response.error"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayMethodResponseConfig,
+		},
+
+		{
+			ResourceName:            resourceName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"response_parameters_in_json"},
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "rest_api_id", "resource_id", "http_method", "status_code")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayMethodResponseDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayMethodSettings_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_method_settings.test"
+
+	rInt := acctest.RandInt()
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayMethodSettingsConfig(rInt),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "rest_api_id", "stage_name", "method_path")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayMethodSettingsDestroy,
+		Steps:        steps,
+	})
+}
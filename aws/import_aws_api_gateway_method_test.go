@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayMethod_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_method.test"
+
+	rInt := acctest.RandInt()
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayMethodConfig(rInt),
+		},
+
+		{
+			ResourceName:            resourceName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"request_parameters_in_json"},
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "rest_api_id", "resource_id", "http_method")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayMethodDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayModel_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_model.test"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSAPIGatewayModelConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "rest_api_id", "name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayModelDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAPIGatewayUsagePlanKey_importBasic(t *testing.T) {
+	resourceName := "aws_api_gateway_usage_plan_key.main"
+
+	name := acctest.RandString(10)
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSApiGatewayUsagePlanKeyBasicConfig(name),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "usage_plan_id", "key_id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayUsagePlanKeyDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSBatchJobDefinition_importBasic(t *testing.T) {
+	resourceName := "aws_batch_job_definition.test"
+	name := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBatchJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchJobDefinitionConfig(name, 128),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
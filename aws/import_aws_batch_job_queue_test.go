@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSBatchJobQueue_importBasic(t *testing.T) {
+	resourceName := "aws_batch_job_queue.test"
+	name := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBatchJobQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchJobQueueConfig(name, 1, batch.JQStateEnabled),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
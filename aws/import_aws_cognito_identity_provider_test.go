@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSCognitoIdentityProvider_importBasic(t *testing.T) {
+	resourceName := "aws_cognito_identity_provider.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(acctest.RandString(10), "email"),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Cognito adds keys to both maps, which are only left out
+				// of the state when the maps are configured.
+				ImportStateVerifyIgnore: []string{"attribute_mapping", "provider_details"},
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSCognitoUserPoolClient_importBasic(t *testing.T) {
+	resourceName := "aws_cognito_user_pool_client.client"
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSCognitoUserPoolClientConfig_allFields(name),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateIdFunc(&steps[1], resourceName, func(a map[string]string) string {
+		return a["user_pool_id"] + "/" + a["id"]
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolClientDestroy,
+		Steps:        steps,
+	})
+}
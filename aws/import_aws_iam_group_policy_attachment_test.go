@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSIamGroupPolicyAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_iam_group_policy_attachment.test-attach"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSGroupPolicyAttachConfig,
+		},
+
+		{
+			ResourceName:     resourceName,
+			ImportState:      true,
+			ImportStateCheck: testAccCheckAWSPolicyAttachmentImportState("group", "test-group", "test-policy"),
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "group", "policy_arn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGroupPolicyAttachmentDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSPolicyAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_iam_policy_attachment.test-attach"
+	user := fmt.Sprintf("test-user-%d", acctest.RandInt())
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSPolicyAttachConfig(user),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "name", "policy_arn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPolicyAttachmentDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRolePolicyAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_iam_role_policy_attachment.test-attach"
+	rInt := acctest.RandInt()
+
+	// The attachment ID is generated, so the imported resource can't be
+	// matched up with the original for ImportStateVerify.
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSRolePolicyAttachConfig(rInt),
+		},
+
+		{
+			ResourceName:     resourceName,
+			ImportState:      true,
+			ImportStateCheck: testAccCheckAWSPolicyAttachmentImportState("role", fmt.Sprintf("test-role-%d", rInt), fmt.Sprintf("tf-acctest-%d", rInt)),
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "role", "policy_arn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRolePolicyAttachmentDestroy,
+		Steps:        steps,
+	})
+}
+
+// testAccCheckAWSPolicyAttachmentImportState checks the state of an imported
+// role, user or group policy attachment.
+func testAccCheckAWSPolicyAttachmentImportState(attr, entityName, policyName string) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 state: %#v", s)
+		}
+
+		if v := s[0].Attributes[attr]; v != entityName {
+			return fmt.Errorf("expected %s %q, got %q", attr, entityName, v)
+		}
+
+		if v := s[0].Attributes["policy_arn"]; !strings.HasSuffix(v, fmt.Sprintf(":policy/%s", policyName)) {
+			return fmt.Errorf("expected policy_arn of policy %q, got %q", policyName, v)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSUserLoginProfile_importBasic(t *testing.T) {
+	resourceName := "aws_iam_user_login_profile.user"
+	username := fmt.Sprintf("test-user-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserLoginProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserLoginProfileConfig(username, "/", testPubKey1),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The password can't be read back, so neither can anything
+				// derived from the PGP key it was encrypted with.
+				ImportStateVerifyIgnore: []string{"pgp_key", "key_fingerprint", "encrypted_password"},
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSUserPolicyAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_iam_user_policy_attachment.test-attach"
+	rName := acctest.RandString(10)
+	policyName := fmt.Sprintf("test-policy-%s", acctest.RandString(10))
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSUserPolicyAttachConfig(rName, policyName),
+		},
+
+		{
+			ResourceName:     resourceName,
+			ImportState:      true,
+			ImportStateCheck: testAccCheckAWSPolicyAttachmentImportState("user", fmt.Sprintf("test-user-%s", rName), policyName),
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "user", "policy_arn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserPolicyAttachmentDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSUserSSHKey_importBasic(t *testing.T) {
+	resourceName := "aws_iam_user_ssh_key.user"
+	ri := acctest.RandInt()
+
+	steps := []resource.TestStep{
+		{
+			Config: fmt.Sprintf(testAccAWSSSHKeyConfig_sshEncoding, ri),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			// IAM drops the comment from the key body it returns.
+			ImportStateVerifyIgnore: []string{"public_key"},
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "username", "ssh_public_key_id", "encoding")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserSSHKeyDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSMainRouteTableAssociation_importBasic(t *testing.T) {
+	resourceName := "aws_main_route_table_association.foo"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccMainRouteTableAssociationConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			// The route table the VPC was created with is only known to
+			// the resource that changed the main route table.
+			ImportStateVerifyIgnore: []string{"original_route_table_id"},
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "vpc_id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMainRouteTableAssociationDestroy,
+		Steps:        steps,
+	})
+}
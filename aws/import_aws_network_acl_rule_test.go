@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSNetworkAclRule_importBasic(t *testing.T) {
+	resourceName := "aws_network_acl_rule.baz"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSNetworkAclRuleBasicConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateIdFunc(&steps[1], resourceName, func(a map[string]string) string {
+		ruleType := "ingress"
+		if a["egress"] == "true" {
+			ruleType = "egress"
+		}
+		return a["network_acl_id"] + "_" + a["rule_number"] + "_" + ruleType
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkAclRuleDestroy,
+		Steps:        steps,
+	})
+}
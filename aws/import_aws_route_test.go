@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSRoute_importBasic(t *testing.T) {
+	resourceName := "aws_route.bar"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSRouteBasicConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateIdFunc(&steps[1], resourceName, func(a map[string]string) string {
+		return a["route_table_id"] + "_" + a["destination_cidr_block"]
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps:        steps,
+	})
+}
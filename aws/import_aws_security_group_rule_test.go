@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSSecurityGroupRule_importBasic(t *testing.T) {
+	resourceName := "aws_security_group_rule.ingress_1"
+	rInt := acctest.RandInt()
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSSecurityGroupRuleIngressConfig(rInt),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateIdFunc(&steps[1], resourceName, func(a map[string]string) string {
+		return strings.Join([]string{
+			a["security_group_id"], a["type"], a["protocol"], a["from_port"], a["to_port"], a["cidr_blocks.0"],
+		}, "_")
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRuleDestroy,
+		Steps:        steps,
+	})
+}
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSServiceCatalogConstraint_importBasic(t *testing.T) {
+	resourceName := "aws_servicecatalog_constraint.test"
+	name := acctest.RandString(5)
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccAWSServiceCatalogConstraintConfig(name, "test-description"),
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateIdFunc(&steps[1], resourceName, func(a map[string]string) string {
+		return a["portfolio_id"] + "/" + a["product_id"] + "/" + a["id"]
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps:        steps,
+	})
+}
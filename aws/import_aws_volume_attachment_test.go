@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSVolumeAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_volume_attachment.ebs_att"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccVolumeAttachmentConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateIdFunc(&steps[1], resourceName, func(a map[string]string) string {
+		return a["device_name"] + ":" + a["volume_id"] + ":" + a["instance_id"]
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVolumeAttachmentDestroy,
+		Steps:        steps,
+	})
+}
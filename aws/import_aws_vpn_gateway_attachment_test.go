@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSVpnGatewayAttachment_importBasic(t *testing.T) {
+	resourceName := "aws_vpn_gateway_attachment.test"
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccVpnGatewayAttachmentConfig,
+		},
+
+		{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	steps[0].Check = testAccSetImportStateId(&steps[1], resourceName, "vpc_id", "vpn_gateway_id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnGatewayAttachmentDestroy,
+		Steps:        steps,
+	})
+}
@@ -63,8 +63,9 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 		},
 
 		"custom_instance_profile_arn": &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateArnOf("iam", "instance-profile"),
 		},
 
 		"elastic_load_balancer": &schema.Schema{
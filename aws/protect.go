@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// providerProtectResource wraps the Delete function of a taggable resource so
+// that it refuses to destroy resources carrying one of the provider's protect
+// tags.
+func providerProtectResource(name string, r *schema.Resource) {
+	if r.Delete == nil || !resourceTaggable(r) {
+		return
+	}
+
+	del := r.Delete
+	r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+		client, ok := meta.(*AWSClient)
+		if !ok || len(client.protectTags) == 0 {
+			return del(d, meta)
+		}
+
+		if p, v, ok := client.protectingTag(r, d); ok {
+			log.Printf("[WARN] Refusing to delete protected %s %s", name, d.Id())
+			return fmt.Errorf("Refusing to destroy %s %s: it carries the tag %q = %q, "+
+				"which is protected by the provider's protect setting. Remove the tag "+
+				"before destroying or replacing the resource.", name, d.Id(), p.Key, v)
+		}
+
+		return del(d, meta)
+	}
+}
+
+// providerProtectCustomizeDiff returns a plan-time check of a taggable
+// resource that refuses to replace resources carrying one of the provider's
+// protect tags, since with create_before_destroy the replacement would already
+// exist by the time the old resource fails to be deleted.
+func providerProtectCustomizeDiff(name string, r *schema.Resource) customizeDiffFunc {
+	if r.Delete == nil || !resourceTaggable(r) {
+		return nil
+	}
+
+	return func(d *resourceDiff, meta interface{}) error {
+		client, ok := meta.(*AWSClient)
+		if !ok || len(client.protectTags) == 0 || d.Id() == "" || !d.RequiresNew() {
+			return nil
+		}
+
+		if p, v, ok := client.protectingTag(r, d.old); ok {
+			return fmt.Errorf("Refusing to replace %s %s: it carries the tag %q = %q, "+
+				"which is protected by the provider's protect setting. Remove the tag "+
+				"before replacing the resource.", name, d.Id(), p.Key, v)
+		}
+		return nil
+	}
+}
+
+// protectingTag returns the first of the provider's protect tags that the
+// resource carries according to its state, along with the tag's value.
+func (c *AWSClient) protectingTag(r *schema.Resource, d *schema.ResourceData) (ProtectTag, string, bool) {
+	tags := resourceTagsFromState(r, d)
+	if s, ok := r.Schema["tags"]; ok && s.Type == schema.TypeMap {
+		// Default tags aren't kept in state, but they are set on the
+		// resource.
+		for k, v := range c.defaultTags {
+			if _, ok := tags[k]; !ok {
+				tags[k] = v.(string)
+			}
+		}
+	}
+
+	for _, p := range c.protectTags {
+		if v, ok := tags[p.Key]; ok && (p.Value == "" || v == p.Value) {
+			return p, v, true
+		}
+	}
+	return ProtectTag{}, "", false
+}
+
+// resourceTaggable reports whether a resource has tags, either as a "tags"
+// map or as "tags"/"tag" lists of key/value blocks like Auto Scaling groups.
+func resourceTaggable(r *schema.Resource) bool {
+	for _, k := range []string{"tags", "tag"} {
+		if _, ok := r.Schema[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceTagsFromState returns the tags of a resource as saved to state.
+func resourceTagsFromState(r *schema.Resource, d *schema.ResourceData) map[string]string {
+	tags := make(map[string]string)
+	for _, attr := range []string{"tags", "tag"} {
+		s, ok := r.Schema[attr]
+		if !ok {
+			continue
+		}
+
+		switch s.Type {
+		case schema.TypeMap:
+			for k, v := range d.Get(attr).(map[string]interface{}) {
+				if value, ok := v.(string); ok {
+					tags[k] = value
+				}
+			}
+		case schema.TypeList, schema.TypeSet:
+			var raw []interface{}
+			if s.Type == schema.TypeSet {
+				raw = d.Get(attr).(*schema.Set).List()
+			} else {
+				raw = d.Get(attr).([]interface{})
+			}
+			for _, tI := range raw {
+				t, ok := tI.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				key, _ := t["key"].(string)
+				value, _ := t["value"].(string)
+				if key != "" {
+					tags[key] = value
+				}
+			}
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestProviderProtectResource(t *testing.T) {
+	var deleted int
+	r := &schema.Resource{
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted++
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}
+	providerProtectResource("aws_test", r)
+
+	client := &AWSClient{
+		protectTags: []ProtectTag{
+			{Key: "Environment", Value: "production"},
+			{Key: "Protected"},
+		},
+	}
+
+	cases := []struct {
+		Tags        map[string]interface{}
+		DefaultTags map[string]interface{}
+		Protected   bool
+	}{
+		{
+			Tags: map[string]interface{}{"Environment": "staging"},
+		},
+		{
+			Tags:      map[string]interface{}{"Environment": "production"},
+			Protected: true,
+		},
+		{
+			Tags:      map[string]interface{}{"Protected": "any"},
+			Protected: true,
+		},
+		{
+			DefaultTags: map[string]interface{}{"Environment": "production"},
+			Protected:   true,
+		},
+		{
+			Tags:        map[string]interface{}{"Environment": "staging"},
+			DefaultTags: map[string]interface{}{"Environment": "production"},
+		},
+	}
+
+	for i, tc := range cases {
+		deleted = 0
+		client.defaultTags = tc.DefaultTags
+
+		d := r.TestResourceData()
+		d.SetId("id")
+		d.Set("tags", tc.Tags)
+
+		err := r.Delete(d, client)
+		if tc.Protected {
+			if err == nil || !strings.Contains(err.Error(), "aws_test id") {
+				t.Fatalf("%d: Expected an error refusing to destroy aws_test id, got %v", i, err)
+			}
+			if deleted != 0 {
+				t.Fatalf("%d: Expected the protected resource not to be deleted", i)
+			}
+		} else {
+			if err != nil {
+				t.Fatalf("%d: %s", i, err)
+			}
+			if deleted != 1 {
+				t.Fatalf("%d: Expected the resource to be deleted", i)
+			}
+		}
+	}
+
+	asg := &schema.Resource{
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"tag": autoscalingTagSchema(),
+		},
+	}
+	providerProtectResource("aws_autoscaling_group", asg)
+
+	d := asg.TestResourceData()
+	d.SetId("asg")
+	d.Set("tag", []interface{}{
+		map[string]interface{}{"key": "Protected", "value": "yes", "propagate_at_launch": true},
+	})
+	if err := asg.Delete(d, client); err == nil {
+		t.Fatal("Expected an error refusing to destroy a protected Auto Scaling group")
+	}
+}
+
+func TestProviderProtectResource_replace(t *testing.T) {
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Read:   func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Update: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+	p := &awsProvider{
+		Provider: &schema.Provider{
+			ResourcesMap: map[string]*schema.Resource{"aws_test": r},
+		},
+		customizeDiff: map[string]customizeDiffFunc{},
+	}
+	p.addCustomizeDiff("aws_test", providerProtectCustomizeDiff("aws_test", r))
+	p.SetMeta(&AWSClient{
+		protectTags: []ProtectTag{{Key: "Protected"}},
+	})
+
+	state := &terraform.InstanceState{
+		ID: "id",
+		Attributes: map[string]string{
+			"id":             "id",
+			"name":           "old",
+			"tags.%":         "1",
+			"tags.Protected": "yes",
+		},
+	}
+	info := &terraform.InstanceInfo{Type: "aws_test"}
+
+	cases := []struct {
+		Config    map[string]interface{}
+		Protected bool
+	}{
+		{
+			Config: map[string]interface{}{
+				"name":        "old",
+				"description": "changed",
+				"tags":        map[string]interface{}{"Protected": "yes"},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"name": "new",
+				"tags": map[string]interface{}{"Protected": "yes"},
+			},
+			Protected: true,
+		},
+	}
+
+	for i, tc := range cases {
+		_, err := p.Diff(info, state, testAwsResourceConfig(t, tc.Config))
+		if tc.Protected {
+			if err == nil || !strings.Contains(err.Error(), "Refusing to replace aws_test id") {
+				t.Fatalf("%d: Expected an error refusing to replace aws_test id, got %v", i, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+	}
+
+	// New resources carrying the tag can be created
+	_, err := p.Diff(info, nil, testAwsResourceConfig(t, map[string]interface{}{
+		"name": "new",
+		"tags": map[string]interface{}{"Protected": "yes"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderConfigure_protectIgnoredTag(t *testing.T) {
+	raw := map[string]interface{}{
+		"region":                      "us-west-2",
+		"access_key":                  "accessKey",
+		"secret_key":                  "secretKey",
+		"skip_credentials_validation": true,
+		"skip_requesting_account_id":  true,
+		"skip_metadata_api_check":     true,
+		"skip_get_ec2_platforms":      true,
+		"ignore_tags": []interface{}{
+			map[string]interface{}{
+				"key_prefixes": []interface{}{"protection:"},
+			},
+		},
+		"protect": []interface{}{
+			map[string]interface{}{
+				"tag_key": "protection:enabled",
+			},
+		},
+	}
+
+	err := Provider().Configure(testAwsResourceConfig(t, raw))
+	if err == nil || !strings.Contains(err.Error(), "matches ignore_tags") {
+		t.Fatalf("Expected an error about the ignored protect tag, got %v", err)
+	}
+}
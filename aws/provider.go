@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"path"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,7 +20,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -43,6 +45,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -50,6 +54,20 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["shared_credentials_file"],
 			},
 
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["shared_config_file"],
+			},
+
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["audit_log_path"],
+			},
+
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -91,6 +109,22 @@ func Provider() terraform.ResourceProvider {
 				Set:           schema.HashString,
 			},
 
+			"allowed_regions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: descriptions["allowed_regions"],
+			},
+
+			"forbidden_regions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: descriptions["forbidden_regions"],
+			},
+
 			"dynamodb_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -109,6 +143,14 @@ func Provider() terraform.ResourceProvider {
 
 			"endpoints": endpointsSchema(),
 
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
+
+			"protect": protectSchema(),
+
+			"iam_preflight": iamPreflightSchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -116,6 +158,28 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["insecure"],
 			},
 
+			"http_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  descriptions["http_proxy"],
+				ValidateFunc: validateHttpProxyUrl,
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["no_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -173,6 +237,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_caller_identity":          dataSourceAwsCallerIdentity(),
 			"aws_canonical_user_id":        dataSourceAwsCanonicalUserId(),
 			"aws_cloudformation_stack":     dataSourceAwsCloudFormationStack(),
+			"aws_cognito_user_pools":       dataSourceAwsCognitoUserPools(),
 			"aws_db_instance":              dataSourceAwsDbInstance(),
 			"aws_db_snapshot":              dataSourceAwsDbSnapshot(),
 			"aws_ebs_snapshot":             dataSourceAwsEbsSnapshot(),
@@ -222,274 +287,331 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_alb":                                      resourceAwsAlb(),
-			"aws_alb_listener":                             resourceAwsAlbListener(),
-			"aws_alb_listener_rule":                        resourceAwsAlbListenerRule(),
-			"aws_alb_target_group":                         resourceAwsAlbTargetGroup(),
-			"aws_alb_target_group_attachment":              resourceAwsAlbTargetGroupAttachment(),
-			"aws_ami":                                      resourceAwsAmi(),
-			"aws_ami_copy":                                 resourceAwsAmiCopy(),
-			"aws_ami_from_instance":                        resourceAwsAmiFromInstance(),
-			"aws_ami_launch_permission":                    resourceAwsAmiLaunchPermission(),
-			"aws_api_gateway_account":                      resourceAwsApiGatewayAccount(),
-			"aws_api_gateway_api_key":                      resourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_authorizer":                   resourceAwsApiGatewayAuthorizer(),
-			"aws_api_gateway_base_path_mapping":            resourceAwsApiGatewayBasePathMapping(),
-			"aws_api_gateway_client_certificate":           resourceAwsApiGatewayClientCertificate(),
-			"aws_api_gateway_deployment":                   resourceAwsApiGatewayDeployment(),
-			"aws_api_gateway_domain_name":                  resourceAwsApiGatewayDomainName(),
-			"aws_api_gateway_gateway_response":             resourceAwsApiGatewayGatewayResponse(),
-			"aws_api_gateway_integration":                  resourceAwsApiGatewayIntegration(),
-			"aws_api_gateway_integration_response":         resourceAwsApiGatewayIntegrationResponse(),
-			"aws_api_gateway_method":                       resourceAwsApiGatewayMethod(),
-			"aws_api_gateway_method_response":              resourceAwsApiGatewayMethodResponse(),
-			"aws_api_gateway_method_settings":              resourceAwsApiGatewayMethodSettings(),
-			"aws_api_gateway_model":                        resourceAwsApiGatewayModel(),
-			"aws_api_gateway_request_validator":            resourceAwsApiGatewayRequestValidator(),
-			"aws_api_gateway_resource":                     resourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":                     resourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_stage":                        resourceAwsApiGatewayStage(),
-			"aws_api_gateway_usage_plan":                   resourceAwsApiGatewayUsagePlan(),
-			"aws_api_gateway_usage_plan_key":               resourceAwsApiGatewayUsagePlanKey(),
-			"aws_app_cookie_stickiness_policy":             resourceAwsAppCookieStickinessPolicy(),
-			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
-			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
-			"aws_autoscaling_attachment":                   resourceAwsAutoscalingAttachment(),
-			"aws_autoscaling_group":                        resourceAwsAutoscalingGroup(),
-			"aws_autoscaling_notification":                 resourceAwsAutoscalingNotification(),
-			"aws_autoscaling_policy":                       resourceAwsAutoscalingPolicy(),
-			"aws_autoscaling_schedule":                     resourceAwsAutoscalingSchedule(),
-			"aws_cloudformation_stack":                     resourceAwsCloudFormationStack(),
-			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
-			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
-			"aws_cloudtrail":                               resourceAwsCloudTrail(),
-			"aws_cloudwatch_event_rule":                    resourceAwsCloudWatchEventRule(),
-			"aws_cloudwatch_event_target":                  resourceAwsCloudWatchEventTarget(),
-			"aws_cloudwatch_log_destination":               resourceAwsCloudWatchLogDestination(),
-			"aws_cloudwatch_log_destination_policy":        resourceAwsCloudWatchLogDestinationPolicy(),
-			"aws_cloudwatch_log_group":                     resourceAwsCloudWatchLogGroup(),
-			"aws_cloudwatch_log_metric_filter":             resourceAwsCloudWatchLogMetricFilter(),
-			"aws_cloudwatch_log_stream":                    resourceAwsCloudWatchLogStream(),
-			"aws_cloudwatch_log_subscription_filter":       resourceAwsCloudwatchLogSubscriptionFilter(),
-			"aws_config_config_rule":                       resourceAwsConfigConfigRule(),
-			"aws_config_configuration_recorder":            resourceAwsConfigConfigurationRecorder(),
-			"aws_config_configuration_recorder_status":     resourceAwsConfigConfigurationRecorderStatus(),
-			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
-			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
-			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
-			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
-			"aws_cloudwatch_dashboard":                     resourceAwsCloudWatchDashboard(),
-			"aws_codedeploy_app":                           resourceAwsCodeDeployApp(),
-			"aws_codedeploy_deployment_config":             resourceAwsCodeDeployDeploymentConfig(),
-			"aws_codedeploy_deployment_group":              resourceAwsCodeDeployDeploymentGroup(),
-			"aws_codecommit_repository":                    resourceAwsCodeCommitRepository(),
-			"aws_codecommit_trigger":                       resourceAwsCodeCommitTrigger(),
-			"aws_codebuild_project":                        resourceAwsCodeBuildProject(),
-			"aws_codepipeline":                             resourceAwsCodePipeline(),
-			"aws_customer_gateway":                         resourceAwsCustomerGateway(),
-			"aws_db_event_subscription":                    resourceAwsDbEventSubscription(),
-			"aws_db_instance":                              resourceAwsDbInstance(),
-			"aws_db_option_group":                          resourceAwsDbOptionGroup(),
-			"aws_db_parameter_group":                       resourceAwsDbParameterGroup(),
-			"aws_db_security_group":                        resourceAwsDbSecurityGroup(),
-			"aws_db_snapshot":                              resourceAwsDbSnapshot(),
-			"aws_db_subnet_group":                          resourceAwsDbSubnetGroup(),
-			"aws_devicefarm_project":                       resourceAwsDevicefarmProject(),
-			"aws_directory_service_directory":              resourceAwsDirectoryServiceDirectory(),
-			"aws_dms_certificate":                          resourceAwsDmsCertificate(),
-			"aws_dms_endpoint":                             resourceAwsDmsEndpoint(),
-			"aws_dms_replication_instance":                 resourceAwsDmsReplicationInstance(),
-			"aws_dms_replication_subnet_group":             resourceAwsDmsReplicationSubnetGroup(),
-			"aws_dms_replication_task":                     resourceAwsDmsReplicationTask(),
-			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
-			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
-			"aws_ebs_volume":                               resourceAwsEbsVolume(),
-			"aws_ecr_repository":                           resourceAwsEcrRepository(),
-			"aws_ecr_repository_policy":                    resourceAwsEcrRepositoryPolicy(),
-			"aws_ecs_cluster":                              resourceAwsEcsCluster(),
-			"aws_ecs_service":                              resourceAwsEcsService(),
-			"aws_ecs_task_definition":                      resourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                          resourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                         resourceAwsEfsMountTarget(),
-			"aws_egress_only_internet_gateway":             resourceAwsEgressOnlyInternetGateway(),
-			"aws_eip":                                      resourceAwsEip(),
-			"aws_eip_association":                          resourceAwsEipAssociation(),
-			"aws_elasticache_cluster":                      resourceAwsElasticacheCluster(),
-			"aws_elasticache_parameter_group":              resourceAwsElasticacheParameterGroup(),
-			"aws_elasticache_replication_group":            resourceAwsElasticacheReplicationGroup(),
-			"aws_elasticache_security_group":               resourceAwsElasticacheSecurityGroup(),
-			"aws_elasticache_subnet_group":                 resourceAwsElasticacheSubnetGroup(),
-			"aws_elastic_beanstalk_application":            resourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_application_version":    resourceAwsElasticBeanstalkApplicationVersion(),
-			"aws_elastic_beanstalk_configuration_template": resourceAwsElasticBeanstalkConfigurationTemplate(),
-			"aws_elastic_beanstalk_environment":            resourceAwsElasticBeanstalkEnvironment(),
-			"aws_elasticsearch_domain":                     resourceAwsElasticSearchDomain(),
-			"aws_elasticsearch_domain_policy":              resourceAwsElasticSearchDomainPolicy(),
-			"aws_elastictranscoder_pipeline":               resourceAwsElasticTranscoderPipeline(),
-			"aws_elastictranscoder_preset":                 resourceAwsElasticTranscoderPreset(),
-			"aws_elb":                                      resourceAwsElb(),
-			"aws_elb_attachment":                           resourceAwsElbAttachment(),
-			"aws_emr_cluster":                              resourceAwsEMRCluster(),
-			"aws_emr_instance_group":                       resourceAwsEMRInstanceGroup(),
-			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
-			"aws_flow_log":                                 resourceAwsFlowLog(),
-			"aws_glacier_vault":                            resourceAwsGlacierVault(),
-			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
-			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
-			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
-			"aws_iam_group_policy":                         resourceAwsIamGroupPolicy(),
-			"aws_iam_group":                                resourceAwsIamGroup(),
-			"aws_iam_group_membership":                     resourceAwsIamGroupMembership(),
-			"aws_iam_group_policy_attachment":              resourceAwsIamGroupPolicyAttachment(),
-			"aws_iam_instance_profile":                     resourceAwsIamInstanceProfile(),
-			"aws_iam_openid_connect_provider":              resourceAwsIamOpenIDConnectProvider(),
-			"aws_iam_policy":                               resourceAwsIamPolicy(),
-			"aws_iam_policy_attachment":                    resourceAwsIamPolicyAttachment(),
-			"aws_iam_role_policy_attachment":               resourceAwsIamRolePolicyAttachment(),
-			"aws_iam_role_policy":                          resourceAwsIamRolePolicy(),
-			"aws_iam_role":                                 resourceAwsIamRole(),
-			"aws_iam_saml_provider":                        resourceAwsIamSamlProvider(),
-			"aws_iam_server_certificate":                   resourceAwsIAMServerCertificate(),
-			"aws_iam_user_policy_attachment":               resourceAwsIamUserPolicyAttachment(),
-			"aws_iam_user_policy":                          resourceAwsIamUserPolicy(),
-			"aws_iam_user_ssh_key":                         resourceAwsIamUserSshKey(),
-			"aws_iam_user":                                 resourceAwsIamUser(),
-			"aws_iam_user_login_profile":                   resourceAwsIamUserLoginProfile(),
-			"aws_inspector_assessment_target":              resourceAWSInspectorAssessmentTarget(),
-			"aws_inspector_assessment_template":            resourceAWSInspectorAssessmentTemplate(),
-			"aws_inspector_resource_group":                 resourceAWSInspectorResourceGroup(),
-			"aws_instance":                                 resourceAwsInstance(),
-			"aws_internet_gateway":                         resourceAwsInternetGateway(),
-			"aws_iot_certificate":                          resourceAwsIotCertificate(),
-			"aws_iot_policy":                               resourceAwsIotPolicy(),
-			"aws_key_pair":                                 resourceAwsKeyPair(),
-			"aws_kinesis_firehose_delivery_stream":         resourceAwsKinesisFirehoseDeliveryStream(),
-			"aws_kinesis_stream":                           resourceAwsKinesisStream(),
-			"aws_kms_alias":                                resourceAwsKmsAlias(),
-			"aws_kms_key":                                  resourceAwsKmsKey(),
-			"aws_lambda_function":                          resourceAwsLambdaFunction(),
-			"aws_lambda_event_source_mapping":              resourceAwsLambdaEventSourceMapping(),
-			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
-			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
-			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
-			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
-			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
-			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
-			"aws_lightsail_static_ip":                      resourceAwsLightsailStaticIp(),
-			"aws_lightsail_static_ip_attachment":           resourceAwsLightsailStaticIpAttachment(),
-			"aws_lb_cookie_stickiness_policy":              resourceAwsLBCookieStickinessPolicy(),
-			"aws_load_balancer_policy":                     resourceAwsLoadBalancerPolicy(),
-			"aws_load_balancer_backend_server_policy":      resourceAwsLoadBalancerBackendServerPolicies(),
-			"aws_load_balancer_listener_policy":            resourceAwsLoadBalancerListenerPolicies(),
-			"aws_lb_ssl_negotiation_policy":                resourceAwsLBSSLNegotiationPolicy(),
-			"aws_main_route_table_association":             resourceAwsMainRouteTableAssociation(),
-			"aws_nat_gateway":                              resourceAwsNatGateway(),
-			"aws_network_acl":                              resourceAwsNetworkAcl(),
-			"aws_default_network_acl":                      resourceAwsDefaultNetworkAcl(),
-			"aws_network_acl_rule":                         resourceAwsNetworkAclRule(),
-			"aws_network_interface":                        resourceAwsNetworkInterface(),
-			"aws_network_interface_attachment":             resourceAwsNetworkInterfaceAttachment(),
-			"aws_opsworks_application":                     resourceAwsOpsworksApplication(),
-			"aws_opsworks_stack":                           resourceAwsOpsworksStack(),
-			"aws_opsworks_java_app_layer":                  resourceAwsOpsworksJavaAppLayer(),
-			"aws_opsworks_haproxy_layer":                   resourceAwsOpsworksHaproxyLayer(),
-			"aws_opsworks_static_web_layer":                resourceAwsOpsworksStaticWebLayer(),
-			"aws_opsworks_php_app_layer":                   resourceAwsOpsworksPhpAppLayer(),
-			"aws_opsworks_rails_app_layer":                 resourceAwsOpsworksRailsAppLayer(),
-			"aws_opsworks_nodejs_app_layer":                resourceAwsOpsworksNodejsAppLayer(),
-			"aws_opsworks_memcached_layer":                 resourceAwsOpsworksMemcachedLayer(),
-			"aws_opsworks_mysql_layer":                     resourceAwsOpsworksMysqlLayer(),
-			"aws_opsworks_ganglia_layer":                   resourceAwsOpsworksGangliaLayer(),
-			"aws_opsworks_custom_layer":                    resourceAwsOpsworksCustomLayer(),
-			"aws_opsworks_instance":                        resourceAwsOpsworksInstance(),
-			"aws_opsworks_user_profile":                    resourceAwsOpsworksUserProfile(),
-			"aws_opsworks_permission":                      resourceAwsOpsworksPermission(),
-			"aws_opsworks_rds_db_instance":                 resourceAwsOpsworksRdsDbInstance(),
-			"aws_placement_group":                          resourceAwsPlacementGroup(),
-			"aws_proxy_protocol_policy":                    resourceAwsProxyProtocolPolicy(),
-			"aws_rds_cluster":                              resourceAwsRDSCluster(),
-			"aws_rds_cluster_instance":                     resourceAwsRDSClusterInstance(),
-			"aws_rds_cluster_parameter_group":              resourceAwsRDSClusterParameterGroup(),
-			"aws_redshift_cluster":                         resourceAwsRedshiftCluster(),
-			"aws_redshift_security_group":                  resourceAwsRedshiftSecurityGroup(),
-			"aws_redshift_parameter_group":                 resourceAwsRedshiftParameterGroup(),
-			"aws_redshift_subnet_group":                    resourceAwsRedshiftSubnetGroup(),
-			"aws_route53_delegation_set":                   resourceAwsRoute53DelegationSet(),
-			"aws_route53_record":                           resourceAwsRoute53Record(),
-			"aws_route53_zone_association":                 resourceAwsRoute53ZoneAssociation(),
-			"aws_route53_zone":                             resourceAwsRoute53Zone(),
-			"aws_route53_health_check":                     resourceAwsRoute53HealthCheck(),
-			"aws_route":                                    resourceAwsRoute(),
-			"aws_route_table":                              resourceAwsRouteTable(),
-			"aws_default_route_table":                      resourceAwsDefaultRouteTable(),
-			"aws_route_table_association":                  resourceAwsRouteTableAssociation(),
-			"aws_ses_active_receipt_rule_set":              resourceAwsSesActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                      resourceAwsSesDomainIdentity(),
-			"aws_ses_receipt_filter":                       resourceAwsSesReceiptFilter(),
-			"aws_ses_receipt_rule":                         resourceAwsSesReceiptRule(),
-			"aws_ses_receipt_rule_set":                     resourceAwsSesReceiptRuleSet(),
-			"aws_ses_configuration_set":                    resourceAwsSesConfigurationSet(),
-			"aws_ses_event_destination":                    resourceAwsSesEventDestination(),
-			"aws_s3_bucket":                                resourceAwsS3Bucket(),
-			"aws_s3_bucket_policy":                         resourceAwsS3BucketPolicy(),
-			"aws_s3_bucket_object":                         resourceAwsS3BucketObject(),
-			"aws_s3_bucket_notification":                   resourceAwsS3BucketNotification(),
-			"aws_security_group":                           resourceAwsSecurityGroup(),
-			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
-			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
-			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
-			"aws_ssm_activation":                           resourceAwsSsmActivation(),
-			"aws_ssm_association":                          resourceAwsSsmAssociation(),
-			"aws_ssm_document":                             resourceAwsSsmDocument(),
-			"aws_ssm_maintenance_window":                   resourceAwsSsmMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":            resourceAwsSsmMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":              resourceAwsSsmMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                       resourceAwsSsmPatchBaseline(),
-			"aws_ssm_patch_group":                          resourceAwsSsmPatchGroup(),
-			"aws_ssm_parameter":                            resourceAwsSsmParameter(),
-			"aws_spot_datafeed_subscription":               resourceAwsSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                    resourceAwsSpotInstanceRequest(),
-			"aws_spot_fleet_request":                       resourceAwsSpotFleetRequest(),
-			"aws_sqs_queue":                                resourceAwsSqsQueue(),
-			"aws_sqs_queue_policy":                         resourceAwsSqsQueuePolicy(),
-			"aws_snapshot_create_volume_permission":        resourceAwsSnapshotCreateVolumePermission(),
-			"aws_sns_topic":                                resourceAwsSnsTopic(),
-			"aws_sns_topic_policy":                         resourceAwsSnsTopicPolicy(),
-			"aws_sns_topic_subscription":                   resourceAwsSnsTopicSubscription(),
-			"aws_sfn_activity":                             resourceAwsSfnActivity(),
-			"aws_sfn_state_machine":                        resourceAwsSfnStateMachine(),
-			"aws_default_subnet":                           resourceAwsDefaultSubnet(),
-			"aws_subnet":                                   resourceAwsSubnet(),
-			"aws_volume_attachment":                        resourceAwsVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":             resourceAwsVpcDhcpOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                 resourceAwsDefaultVpcDhcpOptions(),
-			"aws_vpc_dhcp_options":                         resourceAwsVpcDhcpOptions(),
-			"aws_vpc_peering_connection":                   resourceAwsVpcPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":          resourceAwsVpcPeeringConnectionAccepter(),
-			"aws_default_vpc":                              resourceAwsDefaultVpc(),
-			"aws_vpc":                                      resourceAwsVpc(),
-			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
-			"aws_vpn_connection":                           resourceAwsVpnConnection(),
-			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
-			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
-			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
-			"aws_waf_byte_match_set":                       resourceAwsWafByteMatchSet(),
-			"aws_waf_ipset":                                resourceAwsWafIPSet(),
-			"aws_waf_rule":                                 resourceAwsWafRule(),
-			"aws_waf_rate_based_rule":                      resourceAwsWafRateBasedRule(),
-			"aws_waf_size_constraint_set":                  resourceAwsWafSizeConstraintSet(),
-			"aws_waf_web_acl":                              resourceAwsWafWebAcl(),
-			"aws_waf_xss_match_set":                        resourceAwsWafXssMatchSet(),
-			"aws_waf_sql_injection_match_set":              resourceAwsWafSqlInjectionMatchSet(),
-			"aws_wafregional_byte_match_set":               resourceAwsWafRegionalByteMatchSet(),
-			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
-			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
+			"aws_alb":                                            resourceAwsAlb(),
+			"aws_alb_listener":                                   resourceAwsAlbListener(),
+			"aws_alb_listener_rule":                              resourceAwsAlbListenerRule(),
+			"aws_alb_target_group":                               resourceAwsAlbTargetGroup(),
+			"aws_alb_target_group_attachment":                    resourceAwsAlbTargetGroupAttachment(),
+			"aws_ami":                                            resourceAwsAmi(),
+			"aws_ami_copy":                                       resourceAwsAmiCopy(),
+			"aws_ami_from_instance":                              resourceAwsAmiFromInstance(),
+			"aws_ami_launch_permission":                          resourceAwsAmiLaunchPermission(),
+			"aws_api_gateway_account":                            resourceAwsApiGatewayAccount(),
+			"aws_api_gateway_api_key":                            resourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_authorizer":                         resourceAwsApiGatewayAuthorizer(),
+			"aws_api_gateway_base_path_mapping":                  resourceAwsApiGatewayBasePathMapping(),
+			"aws_api_gateway_client_certificate":                 resourceAwsApiGatewayClientCertificate(),
+			"aws_api_gateway_deployment":                         resourceAwsApiGatewayDeployment(),
+			"aws_api_gateway_domain_name":                        resourceAwsApiGatewayDomainName(),
+			"aws_api_gateway_gateway_response":                   resourceAwsApiGatewayGatewayResponse(),
+			"aws_api_gateway_integration":                        resourceAwsApiGatewayIntegration(),
+			"aws_api_gateway_integration_response":               resourceAwsApiGatewayIntegrationResponse(),
+			"aws_api_gateway_method":                             resourceAwsApiGatewayMethod(),
+			"aws_api_gateway_method_response":                    resourceAwsApiGatewayMethodResponse(),
+			"aws_api_gateway_method_settings":                    resourceAwsApiGatewayMethodSettings(),
+			"aws_api_gateway_model":                              resourceAwsApiGatewayModel(),
+			"aws_api_gateway_request_validator":                  resourceAwsApiGatewayRequestValidator(),
+			"aws_api_gateway_resource":                           resourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                           resourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_stage":                              resourceAwsApiGatewayStage(),
+			"aws_api_gateway_usage_plan":                         resourceAwsApiGatewayUsagePlan(),
+			"aws_api_gateway_usage_plan_key":                     resourceAwsApiGatewayUsagePlanKey(),
+			"aws_app_cookie_stickiness_policy":                   resourceAwsAppCookieStickinessPolicy(),
+			"aws_appautoscaling_target":                          resourceAwsAppautoscalingTarget(),
+			"aws_appautoscaling_policy":                          resourceAwsAppautoscalingPolicy(),
+			"aws_athena_database":                                resourceAwsAthenaDatabase(),
+			"aws_athena_named_query":                             resourceAwsAthenaNamedQuery(),
+			"aws_autoscaling_attachment":                         resourceAwsAutoscalingAttachment(),
+			"aws_autoscaling_group":                              resourceAwsAutoscalingGroup(),
+			"aws_autoscaling_notification":                       resourceAwsAutoscalingNotification(),
+			"aws_autoscaling_policy":                             resourceAwsAutoscalingPolicy(),
+			"aws_autoscaling_schedule":                           resourceAwsAutoscalingSchedule(),
+			"aws_cloudformation_stack":                           resourceAwsCloudFormationStack(),
+			"aws_cloudfront_distribution":                        resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_identity":              resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudtrail":                                     resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_rule":                          resourceAwsCloudWatchEventRule(),
+			"aws_cloudwatch_event_target":                        resourceAwsCloudWatchEventTarget(),
+			"aws_cloudwatch_log_destination":                     resourceAwsCloudWatchLogDestination(),
+			"aws_cloudwatch_log_destination_policy":              resourceAwsCloudWatchLogDestinationPolicy(),
+			"aws_cloudwatch_log_group":                           resourceAwsCloudWatchLogGroup(),
+			"aws_cloudwatch_log_metric_filter":                   resourceAwsCloudWatchLogMetricFilter(),
+			"aws_cloudwatch_log_stream":                          resourceAwsCloudWatchLogStream(),
+			"aws_cloudwatch_log_subscription_filter":             resourceAwsCloudwatchLogSubscriptionFilter(),
+			"aws_config_config_rule":                             resourceAwsConfigConfigRule(),
+			"aws_config_configuration_recorder":                  resourceAwsConfigConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":           resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_delivery_channel":                        resourceAwsConfigDeliveryChannel(),
+			"aws_cognito_identity_pool":                          resourceAwsCognitoIdentityPool(),
+			"aws_cognito_identity_pool_roles_attachment":         resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_identity_provider":                      resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_resource_server":                        resourceAwsCognitoResourceServer(),
+			"aws_cognito_user_group":                             resourceAwsCognitoUserGroup(),
+			"aws_cognito_user_pool":                              resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                       resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                       resourceAwsCognitoUserPoolDomain(),
+			"aws_autoscaling_lifecycle_hook":                     resourceAwsAutoscalingLifecycleHook(),
+			"aws_cloudwatch_metric_alarm":                        resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_dashboard":                           resourceAwsCloudWatchDashboard(),
+			"aws_codedeploy_app":                                 resourceAwsCodeDeployApp(),
+			"aws_codedeploy_deployment_config":                   resourceAwsCodeDeployDeploymentConfig(),
+			"aws_codedeploy_deployment_group":                    resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codecommit_repository":                          resourceAwsCodeCommitRepository(),
+			"aws_codecommit_trigger":                             resourceAwsCodeCommitTrigger(),
+			"aws_codebuild_project":                              resourceAwsCodeBuildProject(),
+			"aws_codepipeline":                                   resourceAwsCodePipeline(),
+			"aws_customer_gateway":                               resourceAwsCustomerGateway(),
+			"aws_db_event_subscription":                          resourceAwsDbEventSubscription(),
+			"aws_db_instance":                                    resourceAwsDbInstance(),
+			"aws_db_option_group":                                resourceAwsDbOptionGroup(),
+			"aws_db_parameter_group":                             resourceAwsDbParameterGroup(),
+			"aws_db_security_group":                              resourceAwsDbSecurityGroup(),
+			"aws_db_snapshot":                                    resourceAwsDbSnapshot(),
+			"aws_db_subnet_group":                                resourceAwsDbSubnetGroup(),
+			"aws_devicefarm_project":                             resourceAwsDevicefarmProject(),
+			"aws_directory_service_directory":                    resourceAwsDirectoryServiceDirectory(),
+			"aws_dms_certificate":                                resourceAwsDmsCertificate(),
+			"aws_dms_endpoint":                                   resourceAwsDmsEndpoint(),
+			"aws_dms_replication_instance":                       resourceAwsDmsReplicationInstance(),
+			"aws_dms_replication_subnet_group":                   resourceAwsDmsReplicationSubnetGroup(),
+			"aws_dms_replication_task":                           resourceAwsDmsReplicationTask(),
+			"aws_dynamodb_table":                                 resourceAwsDynamoDbTable(),
+			"aws_ebs_snapshot":                                   resourceAwsEbsSnapshot(),
+			"aws_ebs_volume":                                     resourceAwsEbsVolume(),
+			"aws_ecr_repository":                                 resourceAwsEcrRepository(),
+			"aws_ecr_repository_policy":                          resourceAwsEcrRepositoryPolicy(),
+			"aws_ecs_cluster":                                    resourceAwsEcsCluster(),
+			"aws_ecs_service":                                    resourceAwsEcsService(),
+			"aws_ecs_task_definition":                            resourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                                resourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                               resourceAwsEfsMountTarget(),
+			"aws_egress_only_internet_gateway":                   resourceAwsEgressOnlyInternetGateway(),
+			"aws_eip":                                            resourceAwsEip(),
+			"aws_eip_association":                                resourceAwsEipAssociation(),
+			"aws_elasticache_cluster":                            resourceAwsElasticacheCluster(),
+			"aws_elasticache_parameter_group":                    resourceAwsElasticacheParameterGroup(),
+			"aws_elasticache_replication_group":                  resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_security_group":                     resourceAwsElasticacheSecurityGroup(),
+			"aws_elasticache_subnet_group":                       resourceAwsElasticacheSubnetGroup(),
+			"aws_elastic_beanstalk_application":                  resourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_application_version":          resourceAwsElasticBeanstalkApplicationVersion(),
+			"aws_elastic_beanstalk_configuration_template":       resourceAwsElasticBeanstalkConfigurationTemplate(),
+			"aws_elastic_beanstalk_environment":                  resourceAwsElasticBeanstalkEnvironment(),
+			"aws_elasticsearch_domain":                           resourceAwsElasticSearchDomain(),
+			"aws_elasticsearch_domain_policy":                    resourceAwsElasticSearchDomainPolicy(),
+			"aws_elastictranscoder_pipeline":                     resourceAwsElasticTranscoderPipeline(),
+			"aws_elastictranscoder_preset":                       resourceAwsElasticTranscoderPreset(),
+			"aws_elb":                                            resourceAwsElb(),
+			"aws_elb_attachment":                                 resourceAwsElbAttachment(),
+			"aws_emr_cluster":                                    resourceAwsEMRCluster(),
+			"aws_emr_instance_group":                             resourceAwsEMRInstanceGroup(),
+			"aws_emr_security_configuration":                     resourceAwsEMRSecurityConfiguration(),
+			"aws_flow_log":                                       resourceAwsFlowLog(),
+			"aws_glacier_vault":                                  resourceAwsGlacierVault(),
+			"aws_iam_access_key":                                 resourceAwsIamAccessKey(),
+			"aws_iam_account_alias":                              resourceAwsIamAccountAlias(),
+			"aws_iam_account_password_policy":                    resourceAwsIamAccountPasswordPolicy(),
+			"aws_iam_group_policy":                               resourceAwsIamGroupPolicy(),
+			"aws_iam_group":                                      resourceAwsIamGroup(),
+			"aws_iam_group_membership":                           resourceAwsIamGroupMembership(),
+			"aws_iam_group_policy_attachment":                    resourceAwsIamGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                           resourceAwsIamInstanceProfile(),
+			"aws_iam_openid_connect_provider":                    resourceAwsIamOpenIDConnectProvider(),
+			"aws_iam_policy":                                     resourceAwsIamPolicy(),
+			"aws_iam_policy_attachment":                          resourceAwsIamPolicyAttachment(),
+			"aws_iam_role_policy_attachment":                     resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_role_policy":                                resourceAwsIamRolePolicy(),
+			"aws_iam_role":                                       resourceAwsIamRole(),
+			"aws_iam_saml_provider":                              resourceAwsIamSamlProvider(),
+			"aws_iam_server_certificate":                         resourceAwsIAMServerCertificate(),
+			"aws_iam_user_policy_attachment":                     resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_user_policy":                                resourceAwsIamUserPolicy(),
+			"aws_iam_user_ssh_key":                               resourceAwsIamUserSshKey(),
+			"aws_iam_user":                                       resourceAwsIamUser(),
+			"aws_iam_user_login_profile":                         resourceAwsIamUserLoginProfile(),
+			"aws_inspector_assessment_target":                    resourceAWSInspectorAssessmentTarget(),
+			"aws_inspector_assessment_template":                  resourceAWSInspectorAssessmentTemplate(),
+			"aws_inspector_resource_group":                       resourceAWSInspectorResourceGroup(),
+			"aws_instance":                                       resourceAwsInstance(),
+			"aws_internet_gateway":                               resourceAwsInternetGateway(),
+			"aws_iot_certificate":                                resourceAwsIotCertificate(),
+			"aws_iot_policy":                                     resourceAwsIotPolicy(),
+			"aws_key_pair":                                       resourceAwsKeyPair(),
+			"aws_kinesis_firehose_delivery_stream":               resourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_kinesis_stream":                                 resourceAwsKinesisStream(),
+			"aws_kms_alias":                                      resourceAwsKmsAlias(),
+			"aws_kms_key":                                        resourceAwsKmsKey(),
+			"aws_lambda_function":                                resourceAwsLambdaFunction(),
+			"aws_lambda_event_source_mapping":                    resourceAwsLambdaEventSourceMapping(),
+			"aws_lambda_alias":                                   resourceAwsLambdaAlias(),
+			"aws_lambda_permission":                              resourceAwsLambdaPermission(),
+			"aws_launch_configuration":                           resourceAwsLaunchConfiguration(),
+			"aws_lightsail_domain":                               resourceAwsLightsailDomain(),
+			"aws_lightsail_instance":                             resourceAwsLightsailInstance(),
+			"aws_lightsail_key_pair":                             resourceAwsLightsailKeyPair(),
+			"aws_lightsail_static_ip":                            resourceAwsLightsailStaticIp(),
+			"aws_lightsail_static_ip_attachment":                 resourceAwsLightsailStaticIpAttachment(),
+			"aws_lb_cookie_stickiness_policy":                    resourceAwsLBCookieStickinessPolicy(),
+			"aws_load_balancer_policy":                           resourceAwsLoadBalancerPolicy(),
+			"aws_load_balancer_backend_server_policy":            resourceAwsLoadBalancerBackendServerPolicies(),
+			"aws_load_balancer_listener_policy":                  resourceAwsLoadBalancerListenerPolicies(),
+			"aws_lb_ssl_negotiation_policy":                      resourceAwsLBSSLNegotiationPolicy(),
+			"aws_main_route_table_association":                   resourceAwsMainRouteTableAssociation(),
+			"aws_nat_gateway":                                    resourceAwsNatGateway(),
+			"aws_network_acl":                                    resourceAwsNetworkAcl(),
+			"aws_default_network_acl":                            resourceAwsDefaultNetworkAcl(),
+			"aws_network_acl_rule":                               resourceAwsNetworkAclRule(),
+			"aws_network_interface":                              resourceAwsNetworkInterface(),
+			"aws_network_interface_attachment":                   resourceAwsNetworkInterfaceAttachment(),
+			"aws_opsworks_application":                           resourceAwsOpsworksApplication(),
+			"aws_opsworks_stack":                                 resourceAwsOpsworksStack(),
+			"aws_opsworks_java_app_layer":                        resourceAwsOpsworksJavaAppLayer(),
+			"aws_opsworks_haproxy_layer":                         resourceAwsOpsworksHaproxyLayer(),
+			"aws_opsworks_static_web_layer":                      resourceAwsOpsworksStaticWebLayer(),
+			"aws_opsworks_php_app_layer":                         resourceAwsOpsworksPhpAppLayer(),
+			"aws_opsworks_rails_app_layer":                       resourceAwsOpsworksRailsAppLayer(),
+			"aws_opsworks_nodejs_app_layer":                      resourceAwsOpsworksNodejsAppLayer(),
+			"aws_opsworks_memcached_layer":                       resourceAwsOpsworksMemcachedLayer(),
+			"aws_opsworks_mysql_layer":                           resourceAwsOpsworksMysqlLayer(),
+			"aws_opsworks_ganglia_layer":                         resourceAwsOpsworksGangliaLayer(),
+			"aws_opsworks_custom_layer":                          resourceAwsOpsworksCustomLayer(),
+			"aws_opsworks_instance":                              resourceAwsOpsworksInstance(),
+			"aws_opsworks_user_profile":                          resourceAwsOpsworksUserProfile(),
+			"aws_opsworks_permission":                            resourceAwsOpsworksPermission(),
+			"aws_opsworks_rds_db_instance":                       resourceAwsOpsworksRdsDbInstance(),
+			"aws_placement_group":                                resourceAwsPlacementGroup(),
+			"aws_proxy_protocol_policy":                          resourceAwsProxyProtocolPolicy(),
+			"aws_rds_cluster":                                    resourceAwsRDSCluster(),
+			"aws_rds_cluster_instance":                           resourceAwsRDSClusterInstance(),
+			"aws_rds_cluster_parameter_group":                    resourceAwsRDSClusterParameterGroup(),
+			"aws_redshift_cluster":                               resourceAwsRedshiftCluster(),
+			"aws_redshift_security_group":                        resourceAwsRedshiftSecurityGroup(),
+			"aws_redshift_parameter_group":                       resourceAwsRedshiftParameterGroup(),
+			"aws_redshift_subnet_group":                          resourceAwsRedshiftSubnetGroup(),
+			"aws_route53_delegation_set":                         resourceAwsRoute53DelegationSet(),
+			"aws_route53_record":                                 resourceAwsRoute53Record(),
+			"aws_route53_zone_association":                       resourceAwsRoute53ZoneAssociation(),
+			"aws_route53_zone":                                   resourceAwsRoute53Zone(),
+			"aws_route53_health_check":                           resourceAwsRoute53HealthCheck(),
+			"aws_route":                                          resourceAwsRoute(),
+			"aws_route_table":                                    resourceAwsRouteTable(),
+			"aws_default_route_table":                            resourceAwsDefaultRouteTable(),
+			"aws_route_table_association":                        resourceAwsRouteTableAssociation(),
+			"aws_servicecatalog_constraint":                      resourceAwsServiceCatalogConstraint(),
+			"aws_servicecatalog_portfolio":                       resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_portfolio_share":                 resourceAwsServiceCatalogPortfolioShare(),
+			"aws_servicecatalog_principal_portfolio_association": resourceAwsServiceCatalogPrincipalPortfolioAssociation(),
+			"aws_servicecatalog_product":                         resourceAwsServiceCatalogProduct(),
+			"aws_servicecatalog_product_portfolio_association":   resourceAwsServiceCatalogProductPortfolioAssociation(),
+			"aws_servicecatalog_tag_option":                      resourceAwsServiceCatalogTagOption(),
+			"aws_servicecatalog_tag_option_resource_association": resourceAwsServiceCatalogTagOptionResourceAssociation(),
+			"aws_ses_active_receipt_rule_set":                    resourceAwsSesActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":                            resourceAwsSesDomainIdentity(),
+			"aws_ses_receipt_filter":                             resourceAwsSesReceiptFilter(),
+			"aws_ses_receipt_rule":                               resourceAwsSesReceiptRule(),
+			"aws_ses_receipt_rule_set":                           resourceAwsSesReceiptRuleSet(),
+			"aws_ses_configuration_set":                          resourceAwsSesConfigurationSet(),
+			"aws_ses_event_destination":                          resourceAwsSesEventDestination(),
+			"aws_s3_bucket":                                      resourceAwsS3Bucket(),
+			"aws_s3_bucket_policy":                               resourceAwsS3BucketPolicy(),
+			"aws_s3_bucket_object":                               resourceAwsS3BucketObject(),
+			"aws_s3_bucket_notification":                         resourceAwsS3BucketNotification(),
+			"aws_security_group":                                 resourceAwsSecurityGroup(),
+			"aws_network_interface_sg_attachment":                resourceAwsNetworkInterfaceSGAttachment(),
+			"aws_default_security_group":                         resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_rule":                            resourceAwsSecurityGroupRule(),
+			"aws_simpledb_domain":                                resourceAwsSimpleDBDomain(),
+			"aws_ssm_activation":                                 resourceAwsSsmActivation(),
+			"aws_ssm_association":                                resourceAwsSsmAssociation(),
+			"aws_ssm_document":                                   resourceAwsSsmDocument(),
+			"aws_ssm_maintenance_window":                         resourceAwsSsmMaintenanceWindow(),
+			"aws_ssm_maintenance_window_target":                  resourceAwsSsmMaintenanceWindowTarget(),
+			"aws_ssm_maintenance_window_task":                    resourceAwsSsmMaintenanceWindowTask(),
+			"aws_ssm_patch_baseline":                             resourceAwsSsmPatchBaseline(),
+			"aws_ssm_patch_group":                                resourceAwsSsmPatchGroup(),
+			"aws_ssm_parameter":                                  resourceAwsSsmParameter(),
+			"aws_spot_datafeed_subscription":                     resourceAwsSpotDataFeedSubscription(),
+			"aws_spot_instance_request":                          resourceAwsSpotInstanceRequest(),
+			"aws_spot_fleet_request":                             resourceAwsSpotFleetRequest(),
+			"aws_sqs_queue":                                      resourceAwsSqsQueue(),
+			"aws_sqs_queue_policy":                               resourceAwsSqsQueuePolicy(),
+			"aws_snapshot_create_volume_permission":              resourceAwsSnapshotCreateVolumePermission(),
+			"aws_sns_topic":                                      resourceAwsSnsTopic(),
+			"aws_sns_topic_policy":                               resourceAwsSnsTopicPolicy(),
+			"aws_sns_topic_subscription":                         resourceAwsSnsTopicSubscription(),
+			"aws_sfn_activity":                                   resourceAwsSfnActivity(),
+			"aws_sfn_state_machine":                              resourceAwsSfnStateMachine(),
+			"aws_default_subnet":                                 resourceAwsDefaultSubnet(),
+			"aws_subnet":                                         resourceAwsSubnet(),
+			"aws_volume_attachment":                              resourceAwsVolumeAttachment(),
+			"aws_vpc_dhcp_options_association":                   resourceAwsVpcDhcpOptionsAssociation(),
+			"aws_default_vpc_dhcp_options":                       resourceAwsDefaultVpcDhcpOptions(),
+			"aws_vpc_dhcp_options":                               resourceAwsVpcDhcpOptions(),
+			"aws_vpc_peering_connection":                         resourceAwsVpcPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                resourceAwsVpcPeeringConnectionAccepter(),
+			"aws_default_vpc":                                    resourceAwsDefaultVpc(),
+			"aws_vpc":                                            resourceAwsVpc(),
+			"aws_vpc_endpoint":                                   resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_route_table_association":           resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpn_connection":                                 resourceAwsVpnConnection(),
+			"aws_vpn_connection_route":                           resourceAwsVpnConnectionRoute(),
+			"aws_vpn_gateway":                                    resourceAwsVpnGateway(),
+			"aws_vpn_gateway_attachment":                         resourceAwsVpnGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                  resourceAwsVpnGatewayRoutePropagation(),
+			"aws_waf_byte_match_set":                             resourceAwsWafByteMatchSet(),
+			"aws_waf_ipset":                                      resourceAwsWafIPSet(),
+			"aws_waf_rule":                                       resourceAwsWafRule(),
+			"aws_waf_rate_based_rule":                            resourceAwsWafRateBasedRule(),
+			"aws_waf_size_constraint_set":                        resourceAwsWafSizeConstraintSet(),
+			"aws_waf_web_acl":                                    resourceAwsWafWebAcl(),
+			"aws_waf_xss_match_set":                              resourceAwsWafXssMatchSet(),
+			"aws_waf_sql_injection_match_set":                    resourceAwsWafSqlInjectionMatchSet(),
+			"aws_wafregional_byte_match_set":                     resourceAwsWafRegionalByteMatchSet(),
+			"aws_wafregional_ipset":                              resourceAwsWafRegionalIPSet(),
+			"aws_batch_compute_environment":                      resourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_definition":                           resourceAwsBatchJobDefinition(),
+			"aws_batch_job_queue":                                resourceAwsBatchJobQueue(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, r := range provider.ResourcesMap {
+		providerTagsResource(r)
+		providerProtectResource(name, r)
+		providerRegionResource(name, r)
+		providerRegionGuardResource(r)
+	}
+
+	return provider
+}
+
+// PluginProvider returns the provider as it is served to Terraform: Provider,
+// with the plan-time checks of the changes to resources run by its Diff.
+func PluginProvider() terraform.ResourceProvider {
+	provider := Provider().(*schema.Provider)
+	p := &awsProvider{
+		Provider: provider,
+
+		customizeDiff: map[string]customizeDiffFunc{
+			"aws_alb_listener":                           resourceAwsAlbListenerCustomizeDiff(),
+			"aws_alb_target_group":                       resourceAwsAlbTargetGroupCustomizeDiff(),
+			"aws_athena_database":                        resourceAwsAthenaDatabaseCustomizeDiff(),
+			"aws_autoscaling_group":                      resourceAwsAutoscalingGroupCustomizeDiff(),
+			"aws_cognito_identity_pool_roles_attachment": resourceAwsCognitoIdentityPoolRolesAttachmentCustomizeDiff(),
+			"aws_cognito_user_pool":                      resourceAwsCognitoUserPoolCustomizeDiff(),
+			"aws_db_instance":                            resourceAwsDbInstanceCustomizeDiff(),
+			"aws_security_group_rule":                    resourceAwsSecurityGroupRuleCustomizeDiff(),
+			"aws_sqs_queue":                              resourceAwsSqsQueueCustomizeDiff(),
+		},
+	}
+
+	for name, r := range provider.ResourcesMap {
+		if protect := providerProtectCustomizeDiff(name, r); protect != nil {
+			p.addCustomizeDiff(name, protect)
+		}
+	}
+
+	return p
 }
 
 var descriptions map[string]string
@@ -511,6 +633,12 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_config_file": "The path to the shared config file. If not set\n" +
+			"this defaults to ~/.aws/config.",
+
+		"audit_log_path": "The path to a file that a JSON line is appended to for every\n" +
+			"AWS API call that may change resources, with sensitive parameters redacted.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -518,15 +646,7 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
-		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"devicefarm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
 
 		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to dynamodb-local.",
@@ -534,24 +654,23 @@ func init() {
 		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to kinesalite.",
 
-		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"iam_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"ec2_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
+			"default value is `false`",
 
-		"elb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"allowed_regions": "Glob patterns of the regions that resources may be managed in,\n" +
+			"e.g. `eu-*`. Also applies to ARNs in resource arguments.",
 
-		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"forbidden_regions": "Glob patterns of the regions that resources may not be managed in.\n" +
+			"Also applies to ARNs in resource arguments.",
 
-		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"http_proxy": "The URL of a proxy to send all HTTP and HTTPS requests to AWS through.\n" +
+			"If not set, the HTTP_PROXY and HTTPS_PROXY environment variables are used.",
 
-		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"no_proxy": "A comma-separated list of host names, domains, IP addresses and CIDR blocks\n" +
+			"that are connected to directly instead of through the proxy.",
 
-		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
-			"default value is `false`",
+		"custom_ca_bundle": "The path to, or the contents of, a PEM encoded bundle of CA certificates\n" +
+			"to trust in addition to the system's.",
 
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
@@ -573,6 +692,32 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags set on a resource\n" +
+			"take precedence over these.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
+		"iam_preflight": "Configuration block for checking that the caller is allowed the IAM actions\n" +
+			"needed by resources before using them.",
+
+		"iam_preflight_resource_types": "The resource types to check the IAM actions of.",
+
+		"protect": "Configuration block with a tag that protects resources from being destroyed.",
+
+		"protect_tag_key": "The key of the tag that protects resources from being destroyed.",
+
+		"protect_tag_value": "The value of the tag that protects resources from being destroyed.\n" +
+			"If omitted, resources with the tag key are protected regardless of its value.",
+
+		"assume_role": "Roles to assume prior to making API calls. Each role is assumed" +
+			" with the credentials of the previous one, in the order of the blocks.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -584,6 +729,19 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session.",
+
+		"assume_role_mfa_serial": "The identification number of the MFA device required" +
+			" to assume the role.",
+
+		"assume_role_token_code": "The code from the MFA device.",
+
+		"assume_role_token_code_command": "A command that prints the code from the MFA" +
+			" device. It is run whenever the role's credentials are refreshed.",
+
+		"assume_role_web_identity_token_file": "The path to a file containing the OpenID" +
+			" Connect token to assume the role with.",
 	}
 }
 
@@ -596,6 +754,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Region:                  d.Get("region").(string),
 		MaxRetries:              d.Get("max_retries").(int),
 		Insecure:                d.Get("insecure").(bool),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -611,42 +771,137 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
+	configPath, err := homedir.Expand(d.Get("shared_config_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.SharedConfigFilename = configPath
 
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
+	caBundle, err := readCABundle(d.Get("custom_ca_bundle").(string))
+	if err != nil {
+		return nil, err
+	}
+	if caBundle != "" {
+		if _, err := caBundleCertPool(caBundle); err != nil {
+			return nil, fmt.Errorf("Error loading custom_ca_bundle: %s", err)
 		}
+	}
+	config.CustomCABundle = caBundle
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
-	} else {
+	auditLogPath, err := homedir.Expand(d.Get("audit_log_path").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.AuditLogPath = auditLogPath
+
+	for _, assumeRoleI := range d.Get("assume_role").([]interface{}) {
+		assumeRole, ok := assumeRoleI.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		config.AssumeRoles = append(config.AssumeRoles, AssumeRole{
+			RoleARN:          assumeRole["role_arn"].(string),
+			SessionName:      assumeRole["session_name"].(string),
+			ExternalID:       assumeRole["external_id"].(string),
+			Policy:           assumeRole["policy"].(string),
+			DurationSeconds:  assumeRole["duration_seconds"].(int),
+			MFASerial:        assumeRole["mfa_serial"].(string),
+			TokenCode:        assumeRole["token_code"].(string),
+			TokenCodeCommand: assumeRole["token_code_command"].(string),
+		})
+
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, DurationSeconds: %d, MFASerial: %q)",
+			assumeRole["role_arn"], assumeRole["session_name"], assumeRole["external_id"], assumeRole["policy"],
+			assumeRole["duration_seconds"], assumeRole["mfa_serial"])
+	}
+	if len(config.AssumeRoles) == 0 {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if v, ok := d.GetOk("assume_role_with_web_identity"); ok {
+		for _, assumeRoleI := range v.([]interface{}) {
+			assumeRole, ok := assumeRoleI.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			tokenFile, err := homedir.Expand(assumeRole["web_identity_token_file"].(string))
+			if err != nil {
+				return nil, err
+			}
+
+			config.AssumeRoleWithWebIdentity = &AssumeRoleWithWebIdentity{
+				RoleARN:              assumeRole["role_arn"].(string),
+				SessionName:          assumeRole["session_name"].(string),
+				WebIdentityTokenFile: tokenFile,
+				Policy:               assumeRole["policy"].(string),
+				DurationSeconds:      assumeRole["duration_seconds"].(int),
+			}
+		}
+	}
+
+	config.Endpoints = make(map[string]string)
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
+		for _, endpointServiceName := range endpointServiceNames() {
+			config.Endpoints[endpointServiceName] = endpoints[endpointServiceName].(string)
+		}
+	}
+
+	if v, ok := d.GetOk("default_tags"); ok {
+		for _, defaultTagsI := range v.([]interface{}) {
+			defaultTags, ok := defaultTagsI.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			config.DefaultTags = defaultTags["tags"].(map[string]interface{})
+		}
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok {
+		for _, ignoreTagsI := range v.([]interface{}) {
+			ignoreTags, ok := ignoreTagsI.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			config.IgnoreTagKeys = aws.StringValueSlice(expandStringList(ignoreTags["keys"].(*schema.Set).List()))
+			config.IgnoreTagKeyPrefixes = aws.StringValueSlice(expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List()))
+		}
+	}
+
+	if v, ok := d.GetOk("iam_preflight"); ok {
+		for _, preflightI := range v.([]interface{}) {
+			preflight, ok := preflightI.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, t := range preflight["resource_types"].(*schema.Set).List() {
+				config.IamPreflightResourceTypes = append(config.IamPreflightResourceTypes, t.(string))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("protect"); ok {
+		for _, protectI := range v.([]interface{}) {
+			protect, ok := protectI.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key := protect["tag_key"].(string)
+			// Ignored tags never make it to state, so they couldn't protect
+			// anything
+			if tagKeyIgnored(key, config.IgnoreTagKeys, config.IgnoreTagKeyPrefixes) {
+				return nil, fmt.Errorf("protect: tag_key %q matches ignore_tags, "+
+					"so resources carrying it wouldn't be protected", key)
+			}
+			config.ProtectTags = append(config.ProtectTags, ProtectTag{
+				Key:   key,
+				Value: protect["tag_value"].(string),
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -657,6 +912,28 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.ForbiddenAccountIds = v.(*schema.Set).List()
 	}
 
+	for _, k := range []string{"allowed_regions", "forbidden_regions"} {
+		v, ok := d.GetOk(k)
+		if !ok {
+			continue
+		}
+
+		var patterns []string
+		for _, patternI := range v.(*schema.Set).List() {
+			pattern := patternI.(string)
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("Invalid pattern %q in %s: %s", pattern, k, err)
+			}
+			patterns = append(patterns, pattern)
+		}
+
+		if k == "allowed_regions" {
+			config.AllowedRegions = patterns
+		} else {
+			config.ForbiddenRegions = patterns
+		}
+	}
+
 	return config.Client()
 }
 
@@ -665,9 +942,9 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["assume_role"],
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -693,111 +970,189 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
-			},
-		},
-	}
-}
 
-func endpointsSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validateIntegerInRange(900, 43200),
 				},
-				"cloudwatchevents": {
+
+				"mfa_serial": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
+					Description: descriptions["assume_role_mfa_serial"],
 				},
-				"cloudwatchlogs": {
+
+				"token_code": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
+					Sensitive:   true,
+					Description: descriptions["assume_role_token_code"],
 				},
-				"cloudformation": {
+
+				"token_code_command": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
+					Description: descriptions["assume_role_token_code_command"],
 				},
-				"devicefarm": {
+			},
+		},
+	}
+}
+
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_role_arn"],
 				},
-				"dynamodb": {
+
+				"web_identity_token_file": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_web_identity_token_file"],
 				},
-				"iam": {
+
+				"session_name": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+					Description: descriptions["assume_role_session_name"],
 				},
 
-				"ec2": {
+				"policy": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+					Description: descriptions["assume_role_policy"],
 				},
 
-				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validateIntegerInRange(900, 43200),
 				},
-				"kinesis": {
-					Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
+					Description: descriptions["default_tags_tags"],
 				},
-				"kms": {
-					Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
 				},
-				"rds": {
-					Type:        schema.TypeString,
+				"key_prefixes": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
 				},
-				"s3": {
+			},
+		},
+	}
+}
+
+func protectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["protect"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tag_key": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
+					Required:    true,
+					Description: descriptions["protect_tag_key"],
 				},
-				"sns": {
+				"tag_value": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
+					Description: descriptions["protect_tag_value"],
 				},
-				"sqs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
+			},
+		},
+	}
+}
+
+func iamPreflightSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["iam_preflight"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_types": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["iam_preflight_resource_types"],
 				},
 			},
 		},
+	}
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, endpointServiceName := range endpointServiceNames() {
+		description, ok := descriptions[endpointServiceName+"_endpoint"]
+		if !ok {
+			description = descriptions["endpoint"]
+		}
+
+		endpointsAttributes[endpointServiceName] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: description,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
+		},
 		Set: endpointsToHash,
 	}
 }
@@ -805,21 +1160,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, endpointServiceName := range endpointServiceNames() {
+		buf.WriteString(fmt.Sprintf("%s-", m[endpointServiceName].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -0,0 +1,1204 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The service stand-ins used by the offline acceptance tests, see
+// provider_offline_test.go. Each one implements only what the core resources
+// need; their state is guarded by the awsStandin mutex.
+
+// EC2
+
+type awsStandinEC2 struct {
+	s    *awsStandin
+	vpcs map[string]*awsStandinVpc
+	tags map[string]map[string]string
+}
+
+type awsStandinVpc struct {
+	id                 string
+	cidrBlock          string
+	instanceTenancy    string
+	dhcpOptionsId      string
+	enableDnsSupport   bool
+	enableDnsHostnames bool
+	mainRouteTableId   string
+	defaultNetworkAcl  string
+	defaultSecGroupId  string
+}
+
+func newAwsStandinEC2(s *awsStandin) *awsStandinEC2 {
+	return &awsStandinEC2{
+		s:    s,
+		vpcs: make(map[string]*awsStandinVpc),
+		tags: make(map[string]map[string]string),
+	}
+}
+
+func (e *awsStandinEC2) handlers() map[string]awsStandinQueryHandler {
+	return map[string]awsStandinQueryHandler{
+		"CreateTags":             e.createTags,
+		"CreateVpc":              e.createVpc,
+		"DeleteTags":             e.deleteTags,
+		"DeleteVpc":              e.deleteVpc,
+		"DescribeNetworkAcls":    e.describeNetworkAcls,
+		"DescribeRouteTables":    e.describeRouteTables,
+		"DescribeSecurityGroups": e.describeSecurityGroups,
+		"DescribeVpcAttribute":   e.describeVpcAttribute,
+		"DescribeVpcs":           e.describeVpcs,
+		"ModifyVpcAttribute":     e.modifyVpcAttribute,
+	}
+}
+
+func (e *awsStandinEC2) vpc(id string) (*awsStandinVpc, error) {
+	vpc, ok := e.vpcs[id]
+	if !ok {
+		return nil, newAwsStandinError(400, "InvalidVpcID.NotFound", "The vpc ID '%s' does not exist", id)
+	}
+	return vpc, nil
+}
+
+func (e *awsStandinEC2) vpcXML(vpc *awsStandinVpc) string {
+	return fmt.Sprintf(`<vpcId>%s</vpcId><state>available</state><cidrBlock>%s</cidrBlock><dhcpOptionsId>%s</dhcpOptionsId><tagSet>%s</tagSet><instanceTenancy>%s</instanceTenancy><isDefault>false</isDefault>`,
+		vpc.id, vpc.cidrBlock, vpc.dhcpOptionsId, awsStandinEC2TagSet(e.tags[vpc.id]), vpc.instanceTenancy)
+}
+
+func (e *awsStandinEC2) createVpc(params url.Values) (string, error) {
+	tenancy := params.Get("InstanceTenancy")
+	if tenancy == "" {
+		tenancy = "default"
+	}
+	vpc := &awsStandinVpc{
+		id:                e.s.id("vpc-"),
+		cidrBlock:         params.Get("CidrBlock"),
+		instanceTenancy:   tenancy,
+		dhcpOptionsId:     "dopt-1f3a0000",
+		enableDnsSupport:  true,
+		mainRouteTableId:  e.s.id("rtb-"),
+		defaultNetworkAcl: e.s.id("acl-"),
+		defaultSecGroupId: e.s.id("sg-"),
+	}
+	e.vpcs[vpc.id] = vpc
+
+	return fmt.Sprintf("<vpc>%s</vpc>", e.vpcXML(vpc)), nil
+}
+
+func (e *awsStandinEC2) describeVpcs(params url.Values) (string, error) {
+	ids := awsStandinList(params, "VpcId")
+	filters := awsStandinFilters(params)
+
+	var buf bytes.Buffer
+	for _, id := range ids {
+		if _, err := e.vpc(id); err != nil {
+			return "", err
+		}
+	}
+	for id, vpc := range e.vpcs {
+		if len(ids) > 0 && !awsStandinContains(ids, id) {
+			continue
+		}
+		if !awsStandinFilterMatches(filters, "vpc-id", id) || !awsStandinFilterMatches(filters, "cidr", vpc.cidrBlock) {
+			continue
+		}
+		fmt.Fprintf(&buf, "<item>%s</item>", e.vpcXML(vpc))
+	}
+
+	return fmt.Sprintf("<vpcSet>%s</vpcSet>", buf.String()), nil
+}
+
+func (e *awsStandinEC2) deleteVpc(params url.Values) (string, error) {
+	vpc, err := e.vpc(params.Get("VpcId"))
+	if err != nil {
+		return "", err
+	}
+	delete(e.vpcs, vpc.id)
+	delete(e.tags, vpc.id)
+
+	return "<return>true</return>", nil
+}
+
+func (e *awsStandinEC2) describeVpcAttribute(params url.Values) (string, error) {
+	vpc, err := e.vpc(params.Get("VpcId"))
+	if err != nil {
+		return "", err
+	}
+
+	switch attr := params.Get("Attribute"); attr {
+	case "enableDnsSupport":
+		return fmt.Sprintf("<vpcId>%s</vpcId><enableDnsSupport><value>%t</value></enableDnsSupport>", vpc.id, vpc.enableDnsSupport), nil
+	case "enableDnsHostnames":
+		return fmt.Sprintf("<vpcId>%s</vpcId><enableDnsHostnames><value>%t</value></enableDnsHostnames>", vpc.id, vpc.enableDnsHostnames), nil
+	default:
+		return "", newAwsStandinError(400, "InvalidParameterValue", "Value (%s) for parameter attribute is invalid", attr)
+	}
+}
+
+func (e *awsStandinEC2) modifyVpcAttribute(params url.Values) (string, error) {
+	vpc, err := e.vpc(params.Get("VpcId"))
+	if err != nil {
+		return "", err
+	}
+
+	if v := params.Get("EnableDnsSupport.Value"); v != "" {
+		vpc.enableDnsSupport = v == "true"
+	}
+	if v := params.Get("EnableDnsHostnames.Value"); v != "" {
+		vpc.enableDnsHostnames = v == "true"
+	}
+
+	return "<return>true</return>", nil
+}
+
+func (e *awsStandinEC2) describeRouteTables(params url.Values) (string, error) {
+	filters := awsStandinFilters(params)
+
+	var buf bytes.Buffer
+	for _, vpc := range e.vpcs {
+		if !awsStandinFilterMatches(filters, "vpc-id", vpc.id) ||
+			!awsStandinFilterMatches(filters, "association.main", "true") ||
+			!awsStandinFilterMatches(filters, "route-table-id", vpc.mainRouteTableId) {
+			continue
+		}
+		fmt.Fprintf(&buf, `<item><routeTableId>%[1]s</routeTableId><vpcId>%[2]s</vpcId><routeSet><item><destinationCidrBlock>%[3]s</destinationCidrBlock><gatewayId>local</gatewayId><state>active</state><origin>CreateRouteTable</origin></item></routeSet><associationSet><item><routeTableAssociationId>rtbassoc-%[4]s</routeTableAssociationId><routeTableId>%[1]s</routeTableId><main>true</main></item></associationSet><propagatingVgwSet/><tagSet/></item>`,
+			vpc.mainRouteTableId, vpc.id, vpc.cidrBlock, strings.TrimPrefix(vpc.mainRouteTableId, "rtb-"))
+	}
+
+	return fmt.Sprintf("<routeTableSet>%s</routeTableSet>", buf.String()), nil
+}
+
+func (e *awsStandinEC2) describeNetworkAcls(params url.Values) (string, error) {
+	filters := awsStandinFilters(params)
+
+	var buf bytes.Buffer
+	for _, vpc := range e.vpcs {
+		if !awsStandinFilterMatches(filters, "vpc-id", vpc.id) || !awsStandinFilterMatches(filters, "default", "true") {
+			continue
+		}
+		fmt.Fprintf(&buf, `<item><networkAclId>%s</networkAclId><vpcId>%s</vpcId><default>true</default><entrySet/><associationSet/><tagSet/></item>`,
+			vpc.defaultNetworkAcl, vpc.id)
+	}
+
+	return fmt.Sprintf("<networkAclSet>%s</networkAclSet>", buf.String()), nil
+}
+
+func (e *awsStandinEC2) describeSecurityGroups(params url.Values) (string, error) {
+	filters := awsStandinFilters(params)
+
+	var buf bytes.Buffer
+	for _, vpc := range e.vpcs {
+		if !awsStandinFilterMatches(filters, "vpc-id", vpc.id) || !awsStandinFilterMatches(filters, "group-name", "default") {
+			continue
+		}
+		fmt.Fprintf(&buf, `<item><ownerId>%s</ownerId><groupId>%s</groupId><groupName>default</groupName><groupDescription>default VPC security group</groupDescription><vpcId>%s</vpcId><ipPermissions/><ipPermissionsEgress/><tagSet/></item>`,
+			testAccOfflineAccountId, vpc.defaultSecGroupId, vpc.id)
+	}
+
+	return fmt.Sprintf("<securityGroupInfo>%s</securityGroupInfo>", buf.String()), nil
+}
+
+func (e *awsStandinEC2) createTags(params url.Values) (string, error) {
+	for _, id := range awsStandinList(params, "ResourceId") {
+		if _, ok := e.tags[id]; !ok {
+			e.tags[id] = make(map[string]string)
+		}
+		for k, v := range awsStandinMap(params, "Tag", "Key", "Value") {
+			e.tags[id][k] = v
+		}
+	}
+
+	return "<return>true</return>", nil
+}
+
+func (e *awsStandinEC2) deleteTags(params url.Values) (string, error) {
+	for _, id := range awsStandinList(params, "ResourceId") {
+		for k := range awsStandinMap(params, "Tag", "Key", "Value") {
+			delete(e.tags[id], k)
+		}
+	}
+
+	return "<return>true</return>", nil
+}
+
+// IAM
+
+type awsStandinIAM struct {
+	s     *awsStandin
+	users map[string]*awsStandinIAMUser
+}
+
+type awsStandinIAMUser struct {
+	name    string
+	path    string
+	id      string
+	created time.Time
+}
+
+func newAwsStandinIAM(s *awsStandin) *awsStandinIAM {
+	return &awsStandinIAM{
+		s:     s,
+		users: make(map[string]*awsStandinIAMUser),
+	}
+}
+
+func (i *awsStandinIAM) handlers() map[string]awsStandinQueryHandler {
+	return map[string]awsStandinQueryHandler{
+		"CreateUser":         i.createUser,
+		"DeleteLoginProfile": i.deleteLoginProfile,
+		"DeleteUser":         i.deleteUser,
+		"GetUser":            i.getUser,
+		"ListAccessKeys":     i.listUserEmpty("AccessKeyMetadata"),
+		"ListGroupsForUser":  i.listUserEmpty("Groups"),
+		"ListMFADevices":     i.listUserEmpty("MFADevices"),
+		"UpdateUser":         i.updateUser,
+	}
+}
+
+func (i *awsStandinIAM) user(name string) (*awsStandinIAMUser, error) {
+	user, ok := i.users[name]
+	if !ok {
+		return nil, newAwsStandinError(404, "NoSuchEntity", "The user with name %s cannot be found.", name)
+	}
+	return user, nil
+}
+
+func (i *awsStandinIAM) userXML(user *awsStandinIAMUser) string {
+	return fmt.Sprintf(`<User><Path>%[1]s</Path><UserName>%[2]s</UserName><Arn>arn:aws:iam::%[3]s:user%[1]s%[2]s</Arn><UserId>%[4]s</UserId><CreateDate>%[5]s</CreateDate></User>`,
+		user.path, user.name, testAccOfflineAccountId, user.id, user.created.Format(time.RFC3339))
+}
+
+func (i *awsStandinIAM) createUser(params url.Values) (string, error) {
+	name := params.Get("UserName")
+	if _, ok := i.users[name]; ok {
+		return "", newAwsStandinError(409, "EntityAlreadyExists", "User with name %s already exists.", name)
+	}
+
+	path := params.Get("Path")
+	if path == "" {
+		path = "/"
+	}
+	user := &awsStandinIAMUser{
+		name:    name,
+		path:    path,
+		id:      strings.ToUpper(i.s.id("AIDA")),
+		created: time.Now().UTC(),
+	}
+	i.users[name] = user
+
+	return i.userXML(user), nil
+}
+
+func (i *awsStandinIAM) getUser(params url.Values) (string, error) {
+	name := params.Get("UserName")
+	if name == "" {
+		// The caller, as used by GetAccountInfo.
+		return fmt.Sprintf(`<User><Path>/</Path><UserName>offline</UserName><Arn>arn:aws:iam::%s:user/offline</Arn><UserId>AIDAOFFLINE</UserId><CreateDate>2017-01-01T00:00:00Z</CreateDate></User>`,
+			testAccOfflineAccountId), nil
+	}
+
+	user, err := i.user(name)
+	if err != nil {
+		return "", err
+	}
+	return i.userXML(user), nil
+}
+
+func (i *awsStandinIAM) updateUser(params url.Values) (string, error) {
+	user, err := i.user(params.Get("UserName"))
+	if err != nil {
+		return "", err
+	}
+
+	if v := params.Get("NewPath"); v != "" {
+		user.path = v
+	}
+	if v := params.Get("NewUserName"); v != "" {
+		delete(i.users, user.name)
+		user.name = v
+		i.users[v] = user
+	}
+
+	return "", nil
+}
+
+func (i *awsStandinIAM) deleteUser(params url.Values) (string, error) {
+	user, err := i.user(params.Get("UserName"))
+	if err != nil {
+		return "", err
+	}
+	delete(i.users, user.name)
+
+	return "", nil
+}
+
+func (i *awsStandinIAM) deleteLoginProfile(params url.Values) (string, error) {
+	if _, err := i.user(params.Get("UserName")); err != nil {
+		return "", err
+	}
+	return "", newAwsStandinError(404, "NoSuchEntity", "Login Profile for User %s cannot be found.", params.Get("UserName"))
+}
+
+// listUserEmpty returns a handler for the List* calls on a user's
+// sub-resources (groups, keys, MFA devices) that the stand-in never has.
+func (i *awsStandinIAM) listUserEmpty(member string) awsStandinQueryHandler {
+	return func(params url.Values) (string, error) {
+		if _, err := i.user(params.Get("UserName")); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("<%[1]s/><IsTruncated>false</IsTruncated>", member), nil
+	}
+}
+
+// SNS
+
+type awsStandinSNS struct {
+	s      *awsStandin
+	topics map[string]map[string]string
+}
+
+func newAwsStandinSNS(s *awsStandin) *awsStandinSNS {
+	return &awsStandinSNS{
+		s:      s,
+		topics: make(map[string]map[string]string),
+	}
+}
+
+func (n *awsStandinSNS) handlers() map[string]awsStandinQueryHandler {
+	return map[string]awsStandinQueryHandler{
+		"CreateTopic":        n.createTopic,
+		"DeleteTopic":        n.deleteTopic,
+		"GetTopicAttributes": n.getTopicAttributes,
+		"SetTopicAttributes": n.setTopicAttributes,
+	}
+}
+
+func (n *awsStandinSNS) topic(arn string) (map[string]string, error) {
+	topic, ok := n.topics[arn]
+	if !ok {
+		return nil, newAwsStandinError(404, "NotFound", "Topic does not exist")
+	}
+	return topic, nil
+}
+
+func (n *awsStandinSNS) createTopic(params url.Values) (string, error) {
+	arn := fmt.Sprintf("arn:aws:sns:%s:%s:%s", testAccOfflineRegion, testAccOfflineAccountId, params.Get("Name"))
+	if _, ok := n.topics[arn]; !ok {
+		n.topics[arn] = map[string]string{
+			"TopicArn":                arn,
+			"Owner":                   testAccOfflineAccountId,
+			"DisplayName":             "",
+			"SubscriptionsConfirmed":  "0",
+			"SubscriptionsPending":    "0",
+			"SubscriptionsDeleted":    "0",
+			"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`,
+			"Policy":                  fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish","SNS:Receive"],"Resource":"%s","Condition":{"StringEquals":{"AWS:SourceOwner":"%s"}}}]}`, arn, testAccOfflineAccountId),
+		}
+	}
+
+	return fmt.Sprintf("<TopicArn>%s</TopicArn>", arn), nil
+}
+
+func (n *awsStandinSNS) getTopicAttributes(params url.Values) (string, error) {
+	topic, err := n.topic(params.Get("TopicArn"))
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, k := range awsStandinSortedKeys(topic) {
+		fmt.Fprintf(&buf, "<entry><key>%s</key><value>%s</value></entry>", k, awsStandinEscape(topic[k]))
+	}
+
+	return fmt.Sprintf("<Attributes>%s</Attributes>", buf.String()), nil
+}
+
+func (n *awsStandinSNS) setTopicAttributes(params url.Values) (string, error) {
+	topic, err := n.topic(params.Get("TopicArn"))
+	if err != nil {
+		return "", err
+	}
+	topic[params.Get("AttributeName")] = params.Get("AttributeValue")
+
+	return "", nil
+}
+
+func (n *awsStandinSNS) deleteTopic(params url.Values) (string, error) {
+	delete(n.topics, params.Get("TopicArn"))
+
+	return "", nil
+}
+
+// SQS
+
+type awsStandinSQS struct {
+	s      *awsStandin
+	queues map[string]map[string]string
+}
+
+func newAwsStandinSQS(s *awsStandin) *awsStandinSQS {
+	return &awsStandinSQS{
+		s:      s,
+		queues: make(map[string]map[string]string),
+	}
+}
+
+func (q *awsStandinSQS) handlers() map[string]awsStandinQueryHandler {
+	return map[string]awsStandinQueryHandler{
+		"CreateQueue":        q.createQueue,
+		"DeleteQueue":        q.deleteQueue,
+		"GetQueueAttributes": q.getQueueAttributes,
+		"GetQueueUrl":        q.getQueueUrl,
+		"SetQueueAttributes": q.setQueueAttributes,
+	}
+}
+
+func (q *awsStandinSQS) queueUrl(name string) string {
+	return fmt.Sprintf("%s/%s/%s", q.s.URL(), testAccOfflineAccountId, name)
+}
+
+func (q *awsStandinSQS) queue(queueUrl string) (map[string]string, error) {
+	queue, ok := q.queues[queueUrl]
+	if !ok {
+		return nil, newAwsStandinError(400, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+	return queue, nil
+}
+
+func (q *awsStandinSQS) createQueue(params url.Values) (string, error) {
+	name := params.Get("QueueName")
+	queueUrl := q.queueUrl(name)
+
+	if _, ok := q.queues[queueUrl]; !ok {
+		now := strconv.FormatInt(time.Now().Unix(), 10)
+		queue := map[string]string{
+			"QueueArn":                              fmt.Sprintf("arn:aws:sqs:%s:%s:%s", testAccOfflineRegion, testAccOfflineAccountId, name),
+			"ApproximateNumberOfMessages":           "0",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+			"CreatedTimestamp":                      now,
+			"LastModifiedTimestamp":                 now,
+			"VisibilityTimeout":                     "30",
+			"MaximumMessageSize":                    "262144",
+			"MessageRetentionPeriod":                "345600",
+			"DelaySeconds":                          "0",
+			"ReceiveMessageWaitTimeSeconds":         "0",
+		}
+		for k, v := range awsStandinMap(params, "Attribute", "Name", "Value") {
+			queue[k] = v
+		}
+		q.queues[queueUrl] = queue
+	}
+
+	return fmt.Sprintf("<QueueUrl>%s</QueueUrl>", queueUrl), nil
+}
+
+func (q *awsStandinSQS) getQueueUrl(params url.Values) (string, error) {
+	queueUrl := q.queueUrl(params.Get("QueueName"))
+	if _, err := q.queue(queueUrl); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("<QueueUrl>%s</QueueUrl>", queueUrl), nil
+}
+
+func (q *awsStandinSQS) getQueueAttributes(params url.Values) (string, error) {
+	queue, err := q.queue(params.Get("QueueUrl"))
+	if err != nil {
+		return "", err
+	}
+
+	names := awsStandinList(params, "AttributeName")
+	var buf bytes.Buffer
+	for _, k := range awsStandinSortedKeys(queue) {
+		if !awsStandinContains(names, "All") && !awsStandinContains(names, k) {
+			continue
+		}
+		fmt.Fprintf(&buf, "<Attribute><Name>%s</Name><Value>%s</Value></Attribute>", k, awsStandinEscape(queue[k]))
+	}
+
+	return buf.String(), nil
+}
+
+func (q *awsStandinSQS) setQueueAttributes(params url.Values) (string, error) {
+	queue, err := q.queue(params.Get("QueueUrl"))
+	if err != nil {
+		return "", err
+	}
+
+	for k, v := range awsStandinMap(params, "Attribute", "Name", "Value") {
+		queue[k] = v
+	}
+	queue["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return "", nil
+}
+
+func (q *awsStandinSQS) deleteQueue(params url.Values) (string, error) {
+	queueUrl := params.Get("QueueUrl")
+	if _, err := q.queue(queueUrl); err != nil {
+		return "", err
+	}
+	delete(q.queues, queueUrl)
+
+	return "", nil
+}
+
+// S3
+
+type awsStandinS3 struct {
+	s       *awsStandin
+	buckets map[string]*awsStandinS3Bucket
+}
+
+type awsStandinS3Bucket struct {
+	created    time.Time
+	tags       map[string]string
+	versioning string
+	policy     string
+}
+
+func newAwsStandinS3(s *awsStandin) *awsStandinS3 {
+	return &awsStandinS3{
+		s:       s,
+		buckets: make(map[string]*awsStandinS3Bucket),
+	}
+}
+
+// serveHTTP handles the S3 REST protocol. Requests are path-style, so the
+// first path segment is the bucket and the query string names the
+// sub-resource (?tagging, ?versioning, ...).
+func (b *awsStandinS3) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	name := path[0]
+	if len(path) > 1 && path[1] != "" {
+		b.writeError(w, b.s.unsupported("s3", "object operations"))
+		return
+	}
+
+	subresource := ""
+	for k := range r.URL.Query() {
+		subresource = k
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+
+	var result string
+	var err error
+	switch r.Method + " " + subresource {
+	case "PUT ":
+		result, err = b.createBucket(name)
+	case "HEAD ":
+		_, err = b.bucket(name)
+	case "DELETE ":
+		result, err = b.deleteBucket(name)
+	case "GET location":
+		result, err = b.getBucketLocation(name)
+	case "GET versioning":
+		result, err = b.getBucketVersioning(name)
+	case "PUT versioning":
+		result, err = b.putBucketVersioning(name, body)
+	case "GET tagging":
+		result, err = b.getBucketTagging(name)
+	case "PUT tagging":
+		result, err = b.putBucketTagging(name, body)
+	case "DELETE tagging":
+		result, err = b.deleteBucketTagging(name)
+	case "GET policy":
+		result, err = b.getBucketPolicy(name)
+	case "PUT policy":
+		result, err = b.putBucketPolicy(name, body)
+	case "DELETE policy":
+		result, err = b.deleteBucketPolicy(name)
+	case "PUT acl":
+		_, err = b.bucket(name)
+	case "GET accelerate":
+		result, err = b.emptyConfiguration(name, "AccelerateConfiguration")
+	case "GET requestPayment":
+		result, err = b.getBucketRequestPayment(name)
+	case "GET logging":
+		result, err = b.emptyConfiguration(name, "BucketLoggingStatus")
+	case "GET cors":
+		result, err = b.missingConfiguration(name, "NoSuchCORSConfiguration", "The CORS configuration does not exist")
+	case "GET website":
+		result, err = b.missingConfiguration(name, "NoSuchWebsiteConfiguration", "The specified bucket does not have a website configuration")
+	case "GET lifecycle":
+		result, err = b.missingConfiguration(name, "NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist")
+	case "GET replication":
+		result, err = b.missingConfiguration(name, "ReplicationConfigurationNotFoundError", "The replication configuration was not found")
+	case "GET versions":
+		result, err = b.listObjectVersions(name)
+	default:
+		err = b.s.unsupported("s3", fmt.Sprintf("%s ?%s", r.Method, subresource))
+	}
+
+	if err != nil {
+		b.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("X-Amz-Request-Id", b.s.requestId())
+	if result == "" {
+		w.WriteHeader(200)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(200)
+	fmt.Fprint(w, xml.Header+result)
+}
+
+func (b *awsStandinS3) writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*awsStandinError)
+	if !ok {
+		e = newAwsStandinError(500, "InternalError", "%s", err)
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("X-Amz-Request-Id", b.s.requestId())
+	w.WriteHeader(e.StatusCode)
+	fmt.Fprintf(w, "%s<Error><Code>%s</Code><Message>%s</Message></Error>", xml.Header, e.Code, awsStandinEscape(e.Message))
+}
+
+func (b *awsStandinS3) bucket(name string) (*awsStandinS3Bucket, error) {
+	bucket, ok := b.buckets[name]
+	if !ok {
+		return nil, newAwsStandinError(404, "NoSuchBucket", "The specified bucket does not exist")
+	}
+	return bucket, nil
+}
+
+func (b *awsStandinS3) createBucket(name string) (string, error) {
+	if _, ok := b.buckets[name]; ok {
+		return "", newAwsStandinError(409, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+	b.buckets[name] = &awsStandinS3Bucket{
+		created: time.Now().UTC(),
+		tags:    make(map[string]string),
+	}
+	return "", nil
+}
+
+func (b *awsStandinS3) deleteBucket(name string) (string, error) {
+	if _, err := b.bucket(name); err != nil {
+		return "", err
+	}
+	delete(b.buckets, name)
+	return "", nil
+}
+
+func (b *awsStandinS3) getBucketLocation(name string) (string, error) {
+	if _, err := b.bucket(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">%s</LocationConstraint>`, testAccOfflineRegion), nil
+}
+
+func (b *awsStandinS3) getBucketVersioning(name string) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	status := ""
+	if bucket.versioning != "" {
+		status = fmt.Sprintf("<Status>%s</Status>", bucket.versioning)
+	}
+	return fmt.Sprintf(`<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">%s</VersioningConfiguration>`, status), nil
+}
+
+func (b *awsStandinS3) putBucketVersioning(name string, body []byte) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	var config struct {
+		Status string
+	}
+	if err := xml.Unmarshal(body, &config); err != nil {
+		return "", newAwsStandinError(400, "MalformedXML", "%s", err)
+	}
+	bucket.versioning = config.Status
+	return "", nil
+}
+
+func (b *awsStandinS3) getBucketTagging(name string) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	if len(bucket.tags) == 0 {
+		return "", newAwsStandinError(404, "NoSuchTagSet", "The TagSet does not exist")
+	}
+	var buf bytes.Buffer
+	for _, k := range awsStandinSortedKeys(bucket.tags) {
+		fmt.Fprintf(&buf, "<Tag><Key>%s</Key><Value>%s</Value></Tag>", awsStandinEscape(k), awsStandinEscape(bucket.tags[k]))
+	}
+	return fmt.Sprintf(`<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet>%s</TagSet></Tagging>`, buf.String()), nil
+}
+
+func (b *awsStandinS3) putBucketTagging(name string, body []byte) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	var tagging struct {
+		TagSet []struct {
+			Key   string
+			Value string
+		} `xml:"TagSet>Tag"`
+	}
+	if err := xml.Unmarshal(body, &tagging); err != nil {
+		return "", newAwsStandinError(400, "MalformedXML", "%s", err)
+	}
+	bucket.tags = make(map[string]string)
+	for _, t := range tagging.TagSet {
+		bucket.tags[t.Key] = t.Value
+	}
+	return "", nil
+}
+
+func (b *awsStandinS3) deleteBucketTagging(name string) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	bucket.tags = make(map[string]string)
+	return "", nil
+}
+
+func (b *awsStandinS3) getBucketPolicy(name string) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	if bucket.policy == "" {
+		return "", newAwsStandinError(404, "NoSuchBucketPolicy", "The bucket policy does not exist")
+	}
+	return bucket.policy, nil
+}
+
+func (b *awsStandinS3) putBucketPolicy(name string, body []byte) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	bucket.policy = string(body)
+	return "", nil
+}
+
+func (b *awsStandinS3) deleteBucketPolicy(name string) (string, error) {
+	bucket, err := b.bucket(name)
+	if err != nil {
+		return "", err
+	}
+	bucket.policy = ""
+	return "", nil
+}
+
+func (b *awsStandinS3) getBucketRequestPayment(name string) (string, error) {
+	if _, err := b.bucket(name); err != nil {
+		return "", err
+	}
+	return `<RequestPaymentConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`, nil
+}
+
+func (b *awsStandinS3) listObjectVersions(name string) (string, error) {
+	if _, err := b.bucket(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`<ListVersionsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>%s</Name><IsTruncated>false</IsTruncated></ListVersionsResult>`, name), nil
+}
+
+// emptyConfiguration returns an empty configuration document for
+// sub-resources the stand-in doesn't model.
+func (b *awsStandinS3) emptyConfiguration(name, element string) (string, error) {
+	if _, err := b.bucket(name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`<%s xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`, element), nil
+}
+
+// missingConfiguration returns the error S3 returns for a sub-resource that
+// has never been configured on an existing bucket.
+func (b *awsStandinS3) missingConfiguration(name, code, message string) (string, error) {
+	if _, err := b.bucket(name); err != nil {
+		return "", err
+	}
+	return "", newAwsStandinError(404, code, "%s", message)
+}
+
+// Route 53
+
+const awsStandinRoute53Namespace = "https://route53.amazonaws.com/doc/2013-04-01/"
+
+type awsStandinRoute53 struct {
+	s     *awsStandin
+	zones map[string]*awsStandinRoute53Zone
+}
+
+type awsStandinRoute53Zone struct {
+	id              string
+	name            string
+	callerReference string
+	comment         string
+	tags            map[string]string
+	records         []*awsStandinRoute53RecordSet
+}
+
+// awsStandinRoute53RecordSet is a resource record set as sent by the
+// provider. Only the fields identifying it are parsed, the rest is kept as
+// XML and returned as is.
+type awsStandinRoute53RecordSet struct {
+	Name          string `xml:"Name"`
+	Type          string `xml:"Type"`
+	SetIdentifier string `xml:"SetIdentifier"`
+	InnerXML      string `xml:",innerxml"`
+}
+
+func newAwsStandinRoute53(s *awsStandin) *awsStandinRoute53 {
+	return &awsStandinRoute53{
+		s:     s,
+		zones: make(map[string]*awsStandinRoute53Zone),
+	}
+}
+
+// serveHTTP handles the Route 53 REST protocol for hosted zones, their
+// record sets and tags, and changes, which are always in sync.
+func (z *awsStandinRoute53) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/2013-04-01/"), "/"), "/")
+	body, _ := ioutil.ReadAll(r.Body)
+
+	status := 200
+	var result string
+	var err error
+	switch {
+	case r.Method == "POST" && len(path) == 1 && path[0] == "hostedzone":
+		status = 201
+		result, err = z.createHostedZone(body)
+	case r.Method == "GET" && len(path) == 2 && path[0] == "hostedzone":
+		result, err = z.getHostedZone(path[1])
+	case r.Method == "POST" && len(path) == 2 && path[0] == "hostedzone":
+		result, err = z.updateHostedZoneComment(path[1], body)
+	case r.Method == "DELETE" && len(path) == 2 && path[0] == "hostedzone":
+		result, err = z.deleteHostedZone(path[1])
+	case r.Method == "GET" && len(path) == 3 && path[0] == "hostedzone" && path[2] == "rrset":
+		result, err = z.listResourceRecordSets(path[1], r.URL.Query())
+	case r.Method == "POST" && len(path) == 3 && path[0] == "hostedzone" && path[2] == "rrset":
+		result, err = z.changeResourceRecordSets(path[1], body)
+	case r.Method == "GET" && len(path) == 2 && path[0] == "change":
+		result = z.response("GetChangeResponse", z.changeInfo(path[1]))
+	case r.Method == "GET" && len(path) == 3 && path[0] == "tags" && path[1] == "hostedzone":
+		result, err = z.listTagsForResource(path[2])
+	case r.Method == "POST" && len(path) == 3 && path[0] == "tags" && path[1] == "hostedzone":
+		result, err = z.changeTagsForResource(path[2], body)
+	default:
+		err = z.s.unsupported("route53", fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	}
+
+	if err != nil {
+		z.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("X-Amzn-Requestid", z.s.requestId())
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(status)
+	fmt.Fprint(w, xml.Header+result)
+}
+
+func (z *awsStandinRoute53) writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*awsStandinError)
+	if !ok {
+		e = newAwsStandinError(500, "InternalFailure", "%s", err)
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(e.StatusCode)
+	fmt.Fprintf(w, `%s<ErrorResponse xmlns="%s"><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`,
+		xml.Header, awsStandinRoute53Namespace, e.Code, awsStandinEscape(e.Message), z.s.requestId())
+}
+
+func (z *awsStandinRoute53) zone(id string) (*awsStandinRoute53Zone, error) {
+	zone, ok := z.zones[id]
+	if !ok {
+		return nil, newAwsStandinError(404, "NoSuchHostedZone", "No hosted zone found with ID: %s", id)
+	}
+	return zone, nil
+}
+
+func (z *awsStandinRoute53) response(element, result string) string {
+	return fmt.Sprintf(`<%[1]s xmlns="%[2]s">%[3]s</%[1]s>`, element, awsStandinRoute53Namespace, result)
+}
+
+// changeInfo returns the ChangeInfo of a change, which is always INSYNC. A
+// new change is made when id is empty.
+func (z *awsStandinRoute53) changeInfo(id string) string {
+	if id == "" {
+		id = strings.ToUpper(z.s.id("C"))
+	}
+	return fmt.Sprintf(`<ChangeInfo><Id>/change/%s</Id><Status>INSYNC</Status><SubmittedAt>%s</SubmittedAt></ChangeInfo>`,
+		id, time.Now().UTC().Format(time.RFC3339))
+}
+
+func (z *awsStandinRoute53) hostedZone(zone *awsStandinRoute53Zone) string {
+	return fmt.Sprintf(`<HostedZone><Id>/hostedzone/%s</Id><Name>%s</Name><CallerReference>%s</CallerReference><Config><Comment>%s</Comment><PrivateZone>false</PrivateZone></Config><ResourceRecordSetCount>%d</ResourceRecordSetCount></HostedZone>`,
+		zone.id, awsStandinEscape(zone.name), awsStandinEscape(zone.callerReference), awsStandinEscape(zone.comment), len(zone.records))
+}
+
+func (z *awsStandinRoute53) delegationSet() string {
+	var buf bytes.Buffer
+	for i := 1; i <= 4; i++ {
+		fmt.Fprintf(&buf, "<NameServer>ns-%d.awsdns-offline.net</NameServer>", i)
+	}
+	return fmt.Sprintf("<DelegationSet><NameServers>%s</NameServers></DelegationSet>", buf.String())
+}
+
+func (z *awsStandinRoute53) createHostedZone(body []byte) (string, error) {
+	var req struct {
+		Name            string `xml:"Name"`
+		CallerReference string `xml:"CallerReference"`
+		Comment         string `xml:"HostedZoneConfig>Comment"`
+	}
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return "", newAwsStandinError(400, "InvalidInput", "%s", err)
+	}
+
+	name := awsStandinRoute53Fqdn(req.Name)
+	zone := &awsStandinRoute53Zone{
+		id:              strings.ToUpper(z.s.id("Z")),
+		name:            name,
+		callerReference: req.CallerReference,
+		comment:         req.Comment,
+		tags:            make(map[string]string),
+		records: []*awsStandinRoute53RecordSet{
+			{
+				Name:     name,
+				Type:     "NS",
+				InnerXML: fmt.Sprintf("<Name>%s</Name><Type>NS</Type><TTL>172800</TTL><ResourceRecords><ResourceRecord><Value>ns-1.awsdns-offline.net.</Value></ResourceRecord></ResourceRecords>", awsStandinEscape(name)),
+			},
+			{
+				Name:     name,
+				Type:     "SOA",
+				InnerXML: fmt.Sprintf("<Name>%s</Name><Type>SOA</Type><TTL>900</TTL><ResourceRecords><ResourceRecord><Value>ns-1.awsdns-offline.net. hostmaster.example.com. 1 7200 900 1209600 86400</Value></ResourceRecord></ResourceRecords>", awsStandinEscape(name)),
+			},
+		},
+	}
+	z.zones[zone.id] = zone
+
+	return z.response("CreateHostedZoneResponse", z.hostedZone(zone)+z.changeInfo("")+z.delegationSet()), nil
+}
+
+func (z *awsStandinRoute53) getHostedZone(id string) (string, error) {
+	zone, err := z.zone(id)
+	if err != nil {
+		return "", err
+	}
+	return z.response("GetHostedZoneResponse", z.hostedZone(zone)+z.delegationSet()), nil
+}
+
+func (z *awsStandinRoute53) updateHostedZoneComment(id string, body []byte) (string, error) {
+	zone, err := z.zone(id)
+	if err != nil {
+		return "", err
+	}
+	var req struct {
+		Comment string `xml:"Comment"`
+	}
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return "", newAwsStandinError(400, "InvalidInput", "%s", err)
+	}
+	zone.comment = req.Comment
+
+	return z.response("UpdateHostedZoneCommentResponse", z.hostedZone(zone)), nil
+}
+
+func (z *awsStandinRoute53) deleteHostedZone(id string) (string, error) {
+	zone, err := z.zone(id)
+	if err != nil {
+		return "", err
+	}
+	if len(zone.records) > 2 {
+		return "", newAwsStandinError(400, "HostedZoneNotEmpty", "The specified hosted zone contains non-required resource record sets and so cannot be deleted.")
+	}
+	delete(z.zones, id)
+
+	return z.response("DeleteHostedZoneResponse", z.changeInfo("")), nil
+}
+
+// listResourceRecordSets lists the record sets of a zone in the order of
+// Route 53, starting at the given name and type.
+func (z *awsStandinRoute53) listResourceRecordSets(id string, query url.Values) (string, error) {
+	zone, err := z.zone(id)
+	if err != nil {
+		return "", err
+	}
+
+	maxItems := 100
+	if v := query.Get("maxitems"); v != "" {
+		if maxItems, err = strconv.Atoi(v); err != nil {
+			return "", newAwsStandinError(400, "InvalidInput", "Invalid maxitems: %s", v)
+		}
+	}
+	start := &awsStandinRoute53RecordSet{
+		Name:          query.Get("name"),
+		Type:          query.Get("type"),
+		SetIdentifier: query.Get("identifier"),
+	}
+
+	records := make([]*awsStandinRoute53RecordSet, len(zone.records))
+	copy(records, zone.records)
+	sort.Slice(records, func(i, j int) bool { return records[i].less(records[j]) })
+
+	var buf bytes.Buffer
+	count := 0
+	truncated := false
+	for _, record := range records {
+		if start.Name != "" && record.less(start) {
+			continue
+		}
+		if count == maxItems {
+			truncated = true
+			break
+		}
+		fmt.Fprintf(&buf, "<ResourceRecordSet>%s</ResourceRecordSet>", record.InnerXML)
+		count++
+	}
+
+	return z.response("ListResourceRecordSetsResponse", fmt.Sprintf("<ResourceRecordSets>%s</ResourceRecordSets><IsTruncated>%t</IsTruncated><MaxItems>%d</MaxItems>",
+		buf.String(), truncated, maxItems)), nil
+}
+
+func (z *awsStandinRoute53) changeResourceRecordSets(id string, body []byte) (string, error) {
+	zone, err := z.zone(id)
+	if err != nil {
+		return "", err
+	}
+	var req struct {
+		Changes []struct {
+			Action            string                     `xml:"Action"`
+			ResourceRecordSet awsStandinRoute53RecordSet `xml:"ResourceRecordSet"`
+		} `xml:"ChangeBatch>Changes>Change"`
+	}
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return "", newAwsStandinError(400, "InvalidInput", "%s", err)
+	}
+
+	records := make([]*awsStandinRoute53RecordSet, len(zone.records))
+	copy(records, zone.records)
+	for _, change := range req.Changes {
+		record := change.ResourceRecordSet
+		i := -1
+		for j, r := range records {
+			if r.same(&record) {
+				i = j
+			}
+		}
+
+		switch {
+		case change.Action == "CREATE" && i >= 0:
+			return "", newAwsStandinError(400, "InvalidChangeBatch", "Tried to create resource record set [name='%s', type='%s'] but it already exists", record.Name, record.Type)
+		case change.Action == "DELETE" && i < 0:
+			return "", newAwsStandinError(400, "InvalidChangeBatch", "Tried to delete resource record set [name='%s', type='%s'] but it was not found", record.Name, record.Type)
+		case change.Action == "DELETE":
+			records = append(records[:i], records[i+1:]...)
+		case i >= 0:
+			records[i] = &record
+		default:
+			records = append(records, &record)
+		}
+	}
+	zone.records = records
+
+	return z.response("ChangeResourceRecordSetsResponse", z.changeInfo("")), nil
+}
+
+func (z *awsStandinRoute53) listTagsForResource(id string) (string, error) {
+	zone, err := z.zone(id)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, k := range awsStandinSortedKeys(zone.tags) {
+		fmt.Fprintf(&buf, "<Tag><Key>%s</Key><Value>%s</Value></Tag>", awsStandinEscape(k), awsStandinEscape(zone.tags[k]))
+	}
+
+	return z.response("ListTagsForResourceResponse", fmt.Sprintf("<ResourceTagSet><ResourceType>hostedzone</ResourceType><ResourceId>%s</ResourceId><Tags>%s</Tags></ResourceTagSet>",
+		zone.id, buf.String())), nil
+}
+
+func (z *awsStandinRoute53) changeTagsForResource(id string, body []byte) (string, error) {
+	zone, err := z.zone(id)
+	if err != nil {
+		return "", err
+	}
+	var req struct {
+		AddTags []struct {
+			Key   string `xml:"Key"`
+			Value string `xml:"Value"`
+		} `xml:"AddTags>Tag"`
+		RemoveTagKeys []string `xml:"RemoveTagKeys>Key"`
+	}
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return "", newAwsStandinError(400, "InvalidInput", "%s", err)
+	}
+
+	for _, k := range req.RemoveTagKeys {
+		delete(zone.tags, k)
+	}
+	for _, tag := range req.AddTags {
+		zone.tags[tag.Key] = tag.Value
+	}
+
+	return z.response("ChangeTagsForResourceResponse", ""), nil
+}
+
+// same reports whether both record sets have the same name, type and set
+// identifier.
+func (r *awsStandinRoute53RecordSet) same(o *awsStandinRoute53RecordSet) bool {
+	return awsStandinRoute53Fqdn(r.Name) == awsStandinRoute53Fqdn(o.Name) &&
+		strings.ToUpper(r.Type) == strings.ToUpper(o.Type) &&
+		r.SetIdentifier == o.SetIdentifier
+}
+
+// less orders record sets like Route 53 does, by name with its labels
+// reversed, then type and set identifier.
+func (r *awsStandinRoute53RecordSet) less(o *awsStandinRoute53RecordSet) bool {
+	rName, oName := awsStandinRoute53SortName(r.Name), awsStandinRoute53SortName(o.Name)
+	if rName != oName {
+		return rName < oName
+	}
+	rType, oType := strings.ToUpper(r.Type), strings.ToUpper(o.Type)
+	if rType != oType {
+		return rType < oType
+	}
+	return r.SetIdentifier < o.SetIdentifier
+}
+
+func awsStandinRoute53Fqdn(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+func awsStandinRoute53SortName(name string) string {
+	labels := strings.Split(strings.TrimSuffix(awsStandinRoute53Fqdn(name), "."), ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, ".")
+}
+
+func awsStandinContains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,415 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Offline acceptance tests
+//
+// Setting TF_ACC_OFFLINE (in addition to TF_ACC) makes testAccPreCheck start
+// an in-process stand-in for the AWS APIs and point every endpoint of the
+// provider at it, so that acceptance tests for the resources it knows about
+// run without network access or credentials:
+//
+//   TF_ACC=1 TF_ACC_OFFLINE=1 go test -v ./aws -run 'TestAccAWSSQSQueue_basic'
+//
+// The stand-in keeps just enough state to implement the subset of the EC2,
+// IAM, Route 53, S3, SNS, SQS and STS APIs used by the core resources. Requests for
+// anything else fail with an error naming the unsupported operation.
+
+const (
+	testAccOfflineAccountId = "123456789012"
+	testAccOfflineRegion    = "us-west-2"
+)
+
+var testAccOfflineOnce sync.Once
+var testAccOfflineServer *awsStandin
+
+func testAccOffline() bool {
+	return os.Getenv("TF_ACC_OFFLINE") != ""
+}
+
+// testAccOfflinePreCheck starts the stand-in (once per test binary) and makes
+// testAccProvider use it regardless of the provider configuration of the test.
+func testAccOfflinePreCheck(t *testing.T) {
+	testAccOfflineOnce.Do(func() {
+		testAccOfflineServer = newAwsStandin()
+		log.Printf("[INFO] Test: Using offline AWS stand-in at %s", testAccOfflineServer.URL())
+
+		testAccProvider.ConfigureFunc = testAccOfflineConfigureFunc(testAccOfflineServer)
+	})
+
+	os.Setenv("AWS_DEFAULT_REGION", testAccOfflineRegion)
+}
+
+// testAccOfflineConfigureFunc returns a ConfigureFunc that configures the
+// provider to use the given stand-in for every endpoint.
+func testAccOfflineConfigureFunc(server *awsStandin) schema.ConfigureFunc {
+	return func(d *schema.ResourceData) (interface{}, error) {
+		endpoints := make(map[string]interface{})
+		for _, endpointServiceName := range endpointServiceNames() {
+			endpoints[endpointServiceName] = server.URL()
+		}
+
+		settings := map[string]interface{}{
+			"access_key":                 "offline",
+			"secret_key":                 "offline",
+			"token":                      "",
+			"profile":                    "",
+			"region":                     testAccOfflineRegion,
+			"endpoints":                  []interface{}{endpoints},
+			"s3_force_path_style":        true,
+			"skip_get_ec2_platforms":     true,
+			"skip_metadata_api_check":    true,
+			"skip_region_validation":     true,
+			"skip_requesting_account_id": false,
+		}
+		for k, v := range settings {
+			if err := d.Set(k, v); err != nil {
+				return nil, fmt.Errorf("Error configuring offline provider (%s): %s", k, err)
+			}
+		}
+
+		return providerConfigure(d)
+	}
+}
+
+// TestAWSStandin_sqsQueue runs without TF_ACC, driving the create, read,
+// update and delete functions of aws_sqs_queue against a stand-in of its own.
+func TestAWSStandin_sqsQueue(t *testing.T) {
+	server := newAwsStandin()
+	defer server.Close()
+
+	defer os.Setenv("AWS_DEFAULT_REGION", os.Getenv("AWS_DEFAULT_REGION"))
+	os.Setenv("AWS_DEFAULT_REGION", testAccOfflineRegion)
+
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = testAccOfflineConfigureFunc(server)
+
+	queueUrl := server.sqs.queueUrl("tf-offline-queue")
+	queueAttribute := func(name, expected string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			server.Lock()
+			defer server.Unlock()
+
+			queue, ok := server.sqs.queues[queueUrl]
+			if !ok {
+				return fmt.Errorf("Queue %s not found in stand-in", queueUrl)
+			}
+			if queue[name] != expected {
+				return fmt.Errorf("Expected queue attribute %s to be %q, got %q", name, expected, queue[name])
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"aws": provider,
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			server.Lock()
+			defer server.Unlock()
+
+			if _, ok := server.sqs.queues[queueUrl]; ok {
+				return fmt.Errorf("Queue %s still exists in stand-in", queueUrl)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSConfigWithDefaults("tf-offline-queue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "id", queueUrl),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "visibility_timeout_seconds", "30"),
+					queueAttribute("VisibilityTimeout", "30"),
+				),
+			},
+			{
+				Config: testAccAWSSQSConfigWithOverrides("tf-offline-queue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "id", queueUrl),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "visibility_timeout_seconds", "60"),
+					queueAttribute("VisibilityTimeout", "60"),
+					queueAttribute("DelaySeconds", "90"),
+				),
+			},
+		},
+	})
+}
+
+// awsStandin is an httptest server answering AWS API requests from in-memory
+// state. Requests are routed by the service name in the SigV4 credential
+// scope of the Authorization header.
+type awsStandin struct {
+	sync.Mutex
+
+	server *httptest.Server
+	nextId int
+
+	ec2     *awsStandinEC2
+	iam     *awsStandinIAM
+	route53 *awsStandinRoute53
+	s3      *awsStandinS3
+	sns     *awsStandinSNS
+	sqs     *awsStandinSQS
+}
+
+// awsStandinError is an API error returned by a stand-in service.
+type awsStandinError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *awsStandinError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func newAwsStandinError(statusCode int, code, format string, args ...interface{}) *awsStandinError {
+	return &awsStandinError{
+		StatusCode: statusCode,
+		Code:       code,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+// awsStandinQueryHandler handles a single action of a query protocol service
+// and returns the XML body of the result element.
+type awsStandinQueryHandler func(params url.Values) (string, error)
+
+func newAwsStandin() *awsStandin {
+	s := &awsStandin{}
+	s.ec2 = newAwsStandinEC2(s)
+	s.iam = newAwsStandinIAM(s)
+	s.route53 = newAwsStandinRoute53(s)
+	s.s3 = newAwsStandinS3(s)
+	s.sns = newAwsStandinSNS(s)
+	s.sqs = newAwsStandinSQS(s)
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *awsStandin) URL() string {
+	return s.server.URL
+}
+
+func (s *awsStandin) Close() {
+	s.server.Close()
+}
+
+// id returns a new pseudo-random looking identifier with the given prefix.
+func (s *awsStandin) id(prefix string) string {
+	s.nextId++
+	return fmt.Sprintf("%s%08x", prefix, 0x1f3a0000+s.nextId)
+}
+
+func (s *awsStandin) requestId() string {
+	s.nextId++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", s.nextId)
+}
+
+var awsStandinCredentialScope = regexp.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`)
+
+func (s *awsStandin) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	body, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	service := ""
+	if m := awsStandinCredentialScope.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		service = m[1]
+	}
+	log.Printf("[DEBUG] AWS stand-in received %s request %s %s: %s", service, r.Method, r.URL, body)
+
+	if service == "s3" {
+		s.s3.serveHTTP(w, r)
+		return
+	}
+	if service == "route53" {
+		s.route53.serveHTTP(w, r)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		s.writeQueryError(w, service, newAwsStandinError(400, "MalformedQueryString", "%s", err))
+		return
+	}
+	action := r.Form.Get("Action")
+
+	var handlers map[string]awsStandinQueryHandler
+	switch service {
+	case "ec2":
+		handlers = s.ec2.handlers()
+	case "iam":
+		handlers = s.iam.handlers()
+	case "sns":
+		handlers = s.sns.handlers()
+	case "sqs":
+		handlers = s.sqs.handlers()
+	case "sts":
+		handlers = map[string]awsStandinQueryHandler{
+			"GetCallerIdentity": s.stsGetCallerIdentity,
+		}
+	}
+
+	handler, ok := handlers[action]
+	if !ok {
+		s.writeQueryError(w, service, s.unsupported(service, action))
+		return
+	}
+
+	result, err := handler(r.Form)
+	if err != nil {
+		s.writeQueryError(w, service, err)
+		return
+	}
+
+	s.writeQueryResult(w, service, action, result)
+}
+
+func (s *awsStandin) unsupported(service, operation string) *awsStandinError {
+	code := "InvalidAction"
+	if service == "ec2" {
+		// The provider already copes with this for region specific EC2
+		// features such as ClassicLink.
+		code = "UnsupportedOperation"
+	}
+	return newAwsStandinError(400, code,
+		"The functionality you requested is not available in this region (offline stand-in does not support %s %s)",
+		service, operation)
+}
+
+func (s *awsStandin) writeQueryResult(w http.ResponseWriter, service, action, result string) {
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(200)
+
+	if service == "ec2" {
+		fmt.Fprintf(w, `<%[1]sResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>%[2]s</requestId>%[3]s</%[1]sResponse>`,
+			action, s.requestId(), result)
+		return
+	}
+
+	fmt.Fprintf(w, `<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>%[3]s</RequestId></ResponseMetadata></%[1]sResponse>`,
+		action, result, s.requestId())
+}
+
+func (s *awsStandin) writeQueryError(w http.ResponseWriter, service string, err error) {
+	e, ok := err.(*awsStandinError)
+	if !ok {
+		e = newAwsStandinError(500, "InternalFailure", "%s", err)
+	}
+	log.Printf("[DEBUG] AWS stand-in %s error: %s", service, e)
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(e.StatusCode)
+
+	if service == "ec2" {
+		fmt.Fprintf(w, `<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>%s</RequestID></Response>`,
+			e.Code, awsStandinEscape(e.Message), s.requestId())
+		return
+	}
+
+	fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`,
+		e.Code, awsStandinEscape(e.Message), s.requestId())
+}
+
+func (s *awsStandin) stsGetCallerIdentity(params url.Values) (string, error) {
+	return fmt.Sprintf(`<Arn>arn:aws:iam::%[1]s:user/offline</Arn><UserId>AIDAOFFLINE</UserId><Account>%[1]s</Account>`,
+		testAccOfflineAccountId), nil
+}
+
+func awsStandinEscape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// awsStandinList returns the values of a query protocol list parameter such as
+// VpcId.1, VpcId.2, ... for prefix "VpcId".
+func awsStandinList(params url.Values, prefix string) []string {
+	var result []string
+	for i := 1; ; i++ {
+		v, ok := params[fmt.Sprintf("%s.%d", prefix, i)]
+		if !ok {
+			return result
+		}
+		result = append(result, v[0])
+	}
+}
+
+// awsStandinMap returns the values of a query protocol map parameter, where the
+// entries are passed as <prefix>.N.<key>=K and <prefix>.N.<value>=V.
+func awsStandinMap(params url.Values, prefix, key, value string) map[string]string {
+	result := make(map[string]string)
+	for i := 1; ; i++ {
+		k, ok := params[fmt.Sprintf("%s.%d.%s", prefix, i, key)]
+		if !ok {
+			return result
+		}
+		result[k[0]] = params.Get(fmt.Sprintf("%s.%d.%s", prefix, i, value))
+	}
+}
+
+// awsStandinFilters returns the EC2 style Filter.N.Name/Filter.N.Value.M
+// parameters as a map of filter name to values.
+func awsStandinFilters(params url.Values) map[string][]string {
+	result := make(map[string][]string)
+	for i := 1; ; i++ {
+		name := params.Get(fmt.Sprintf("Filter.%d.Name", i))
+		if name == "" {
+			return result
+		}
+		result[name] = awsStandinList(params, fmt.Sprintf("Filter.%d.Value", i))
+	}
+}
+
+// awsStandinFilterMatches reports whether value satisfies the filter with the given
+// name. A filter that isn't present matches everything.
+func awsStandinFilterMatches(filters map[string][]string, name, value string) bool {
+	values, ok := filters[name]
+	if !ok {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func awsStandinEC2TagSet(tags map[string]string) string {
+	var buf bytes.Buffer
+	for _, k := range awsStandinSortedKeys(tags) {
+		fmt.Fprintf(&buf, "<item><key>%s</key><value>%s</value></item>", awsStandinEscape(k), awsStandinEscape(tags[k]))
+	}
+	return buf.String()
+}
+
+func awsStandinSortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
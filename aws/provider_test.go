@@ -1,10 +1,13 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-template/template"
@@ -43,7 +46,9 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("AWS_PROFILE"); v == "" {
+	if testAccOffline() {
+		testAccOfflinePreCheck(t)
+	} else if v := os.Getenv("AWS_PROFILE"); v == "" && testAccCassetteMode() != testAccCassetteReplay {
 		if v := os.Getenv("AWS_ACCESS_KEY_ID"); v == "" {
 			t.Fatal("AWS_ACCESS_KEY_ID must be set for acceptance tests")
 		}
@@ -51,6 +56,9 @@ func testAccPreCheck(t *testing.T) {
 			t.Fatal("AWS_SECRET_ACCESS_KEY must be set for acceptance tests")
 		}
 	}
+	if testAccCassetteMode() != "" {
+		testAccCassettePreCheck(t)
+	}
 	if v := os.Getenv("AWS_DEFAULT_REGION"); v == "" {
 		log.Println("[INFO] Test: Using us-west-2 as test region")
 		os.Setenv("AWS_DEFAULT_REGION", "us-west-2")
@@ -70,3 +78,32 @@ func testAccEC2ClassicPreCheck(t *testing.T) {
 			region, platforms)
 	}
 }
+
+// testAccSetImportStateId returns a check that sets the ID of the given import
+// step to the values of attrs of the named resource, joined by "/". It is for
+// resources imported by composite IDs, which aren't known until the resources
+// are created.
+func testAccSetImportStateId(step *resource.TestStep, name string, attrs ...string) resource.TestCheckFunc {
+	return testAccSetImportStateIdFunc(step, name, func(a map[string]string) string {
+		parts := make([]string, 0, len(attrs))
+		for _, attr := range attrs {
+			parts = append(parts, a[attr])
+		}
+		return strings.Join(parts, "/")
+	})
+}
+
+// testAccSetImportStateIdFunc is like testAccSetImportStateId, but builds the
+// ID from the attributes of the named resource with f.
+func testAccSetImportStateIdFunc(step *resource.TestStep, name string, f func(map[string]string) string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		step.ImportStateId = f(rs.Primary.Attributes)
+
+		return nil
+	}
+}
@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// awsRegionalClients builds and caches the copies of an AWSClient whose
+// service clients make calls to other regions than the provider's.
+type awsRegionalClients struct {
+	sync.Mutex
+
+	clients map[string]*AWSClient
+
+	// init sets up the service clients of an AWSClient for a region.
+	init func(*AWSClient, string)
+}
+
+// RegionalClient returns a copy of the client for the given region, built on
+// first use. It returns the client itself for its own region or an empty one.
+func (c *AWSClient) RegionalClient(region string) *AWSClient {
+	if region == "" || region == c.region || c.regionalClients == nil {
+		return c
+	}
+
+	rc := c.regionalClients
+	rc.Lock()
+	defer rc.Unlock()
+
+	if client, ok := rc.clients[region]; ok {
+		return client
+	}
+
+	log.Printf("[INFO] Building AWS service clients for region %s", region)
+	client := *c
+	client.region = region
+	rc.init(&client, region)
+	rc.clients[region] = &client
+
+	return &client
+}
+
+// awsGlobalResourcePrefixes are the prefixes of the names of resources of
+// global services, whose region is irrelevant.
+var awsGlobalResourcePrefixes = []string{
+	"aws_cloudfront_",
+	"aws_iam_",
+	"aws_route53_",
+	"aws_waf_",
+}
+
+// awsOwnRegionResources are the resources with a `region` attribute of their
+// own that names the region they are managed in. It selects their service
+// clients instead of the argument added to other resources, and is left to
+// the resource to set.
+var awsOwnRegionResources = map[string]bool{
+	"aws_s3_bucket": true,
+}
+
+// providerRegionResource adds the optional `region` argument to a resource,
+// and makes its CRUD functions use the service clients of that region.
+// Resources of global services are left alone, and so are those that already
+// have a `region` attribute of their own, unless it's listed in
+// awsOwnRegionResources.
+func providerRegionResource(name string, r *schema.Resource) {
+	_, ownRegion := r.Schema["region"]
+	if ownRegion && !awsOwnRegionResources[name] {
+		return
+	}
+	for _, prefix := range awsGlobalResourcePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return
+		}
+	}
+
+	if !ownRegion {
+		r.Schema["region"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		}
+	}
+
+	// regionalClient returns the client for the region of the resource. Once
+	// the resource exists, its region is saved to state.
+	regionalClient := func(d *schema.ResourceData, meta interface{}) (interface{}, func() error, error) {
+		client, ok := meta.(*AWSClient)
+		if !ok {
+			return meta, func() error { return nil }, nil
+		}
+
+		region := d.Get("region").(string)
+		if err := client.validateRegionAllowed(region); err != nil {
+			return nil, nil, err
+		}
+
+		client = client.RegionalClient(region)
+		return client, func() error {
+			if ownRegion || d.Id() == "" {
+				return nil
+			}
+			return d.Set("region", client.region)
+		}, nil
+	}
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			meta, setRegion, err := regionalClient(d, meta)
+			if err != nil {
+				return err
+			}
+
+			err = f(d, meta)
+			if serr := setRegion(); serr != nil && err == nil {
+				err = serr
+			}
+			return err
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer = &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if id, region, ok := splitImportIdRegion(d.Id()); ok {
+					d.SetId(id)
+					if err := d.Set("region", region); err != nil {
+						return nil, err
+					}
+				}
+
+				meta, _, err := regionalClient(d, meta)
+				if err != nil {
+					return nil, err
+				}
+
+				results, err := state(d, meta)
+				if err != nil {
+					return nil, err
+				}
+
+				// Importers may return resources of other types, which
+				// don't all have a region argument
+				if client, ok := meta.(*AWSClient); ok && !ownRegion {
+					for _, result := range results {
+						result.Set("region", client.region)
+					}
+				}
+				return results, nil
+			},
+		}
+	}
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			meta, _, err := regionalClient(d, meta)
+			if err != nil {
+				return false, err
+			}
+			return exists(d, meta)
+		}
+	}
+}
+
+// importIdRegionRegexp matches the region suffix of an import ID, e.g.
+// "@eu-central-1" or "@us-gov-west-1".
+var importIdRegionRegexp = regexp.MustCompile(`@([a-z]{2}(-gov|-iso[a-z]?)?-[a-z]+-[0-9]+)$`)
+
+// splitImportIdRegion splits an import ID of the form ID@REGION into the ID of
+// the resource and its region.
+func splitImportIdRegion(id string) (string, string, bool) {
+	m := importIdRegionRegexp.FindStringSubmatchIndex(id)
+	if m == nil || m[0] == 0 {
+		return id, "", false
+	}
+	return id[:m[0]], id[m[2]:m[3]], true
+}
+
+// validateRegionAllowed returns an error if a region matches one of the
+// forbidden glob patterns, or if allowed patterns are given and it matches
+// none of them.
+func validateRegionAllowed(region string, allowed, forbidden []string) error {
+	for _, pattern := range forbidden {
+		if ok, _ := path.Match(pattern, region); ok {
+			return fmt.Errorf("Forbidden region (%s)", region)
+		}
+	}
+
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, pattern := range allowed {
+		if ok, _ := path.Match(pattern, region); ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("Region not allowed (%s)", region)
+}
+
+// validateRegionAllowed checks a region against the allowed_regions and
+// forbidden_regions of the provider. An empty region is the provider's own,
+// which was checked when it was configured.
+func (c *AWSClient) validateRegionAllowed(region string) error {
+	if region == "" {
+		return nil
+	}
+	return validateRegionAllowed(region, c.allowedRegions, c.forbiddenRegions)
+}
+
+// providerRegionGuardResource wraps the Create and Update functions of a
+// resource so that they refuse regions that aren't allowed by the provider's
+// allowed_regions and forbidden_regions, both in a region argument and in ARNs
+// in its arguments, e.g. a kms_key_id or role_arn.
+func providerRegionGuardResource(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			client, ok := meta.(*AWSClient)
+			if !ok || (len(client.allowedRegions) == 0 && len(client.forbiddenRegions) == 0) {
+				return f(d, meta)
+			}
+
+			// Some resources have a region argument of their own
+			if s, ok := r.Schema["region"]; ok && s.Type == schema.TypeString {
+				if err := client.validateRegionAllowed(d.Get("region").(string)); err != nil {
+					return err
+				}
+			}
+
+			for k, s := range r.Schema {
+				if s.Computed && !s.Optional {
+					continue
+				}
+
+				var err error
+				walkArns(k, d.Get(k), func(key string, arn *awsArn) {
+					if err != nil || arn.Region == "" {
+						return
+					}
+					if rerr := client.validateRegionAllowed(arn.Region); rerr != nil {
+						err = fmt.Errorf("%s: %s in ARN %s", key, rerr, arn)
+					}
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Update = wrap(r.Update)
+}
+
+// walkArns calls f with every ARN in an attribute value, along with its key.
+func walkArns(key string, v interface{}, f func(string, *awsArn)) {
+	switch v := v.(type) {
+	case string:
+		if !strings.HasPrefix(v, "arn:") {
+			return
+		}
+		if arn, err := parseArn(v); err == nil {
+			f(key, arn)
+		}
+	case []interface{}:
+		for i, e := range v {
+			walkArns(fmt.Sprintf("%s.%d", key, i), e, f)
+		}
+	case *schema.Set:
+		walkArns(key, v.List(), f)
+	case map[string]interface{}:
+		for k, e := range v {
+			walkArns(key+"."+k, e, f)
+		}
+	}
+}
@@ -0,0 +1,367 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		Endpoints:               map[string]string{},
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := raw.(*AWSClient)
+
+	if rc := client.RegionalClient(""); rc != client {
+		t.Fatal("Expected the client itself for an empty region")
+	}
+	if rc := client.RegionalClient("us-west-2"); rc != client {
+		t.Fatal("Expected the client itself for its own region")
+	}
+
+	rc := client.RegionalClient("eu-west-1")
+	if rc.region != "eu-west-1" {
+		t.Fatalf("Expected region eu-west-1, got %s", rc.region)
+	}
+	if region := aws.StringValue(rc.ec2conn.Config.Region); region != "eu-west-1" {
+		t.Fatalf("Expected EC2 client for eu-west-1, got %s", region)
+	}
+	if region := aws.StringValue(client.ec2conn.Config.Region); region != "us-west-2" {
+		t.Fatalf("Expected EC2 client of the provider to stay in us-west-2, got %s", region)
+	}
+	if region := aws.StringValue(rc.r53conn.Config.Region); region != "us-east-1" {
+		t.Fatalf("Expected Route 53 client in us-east-1, got %s", region)
+	}
+	if client.RegionalClient("eu-west-1") != rc {
+		t.Fatal("Expected the regional client to be cached")
+	}
+}
+
+func TestProviderRegionResource(t *testing.T) {
+	var regions []string
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			regions = append(regions, meta.(*AWSClient).region)
+			d.SetId("id")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			regions = append(regions, meta.(*AWSClient).region)
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+	providerRegionResource("aws_test", r)
+
+	client := &AWSClient{
+		region: "us-west-2",
+		regionalClients: &awsRegionalClients{
+			clients: make(map[string]*AWSClient),
+			init:    func(*AWSClient, string) {},
+		},
+	}
+
+	d := r.TestResourceData()
+	if err := r.Create(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("region").(string); v != "us-west-2" {
+		t.Fatalf("Expected region us-west-2 in state, got %q", v)
+	}
+
+	d = r.TestResourceData()
+	d.Set("region", "eu-west-1")
+	if err := r.Create(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Read(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"us-west-2", "eu-west-1", "eu-west-1"}
+	if len(regions) != len(expected) {
+		t.Fatalf("Expected calls for regions %q, got %q", expected, regions)
+	}
+	for i := range expected {
+		if regions[i] != expected[i] {
+			t.Fatalf("Expected calls for regions %q, got %q", expected, regions)
+		}
+	}
+
+	global := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	providerRegionResource("aws_iam_role", global)
+	if _, ok := global.Schema["region"]; ok {
+		t.Fatal("Expected no region argument on a resource of a global service")
+	}
+}
+
+func TestProviderRegionResource_ownRegion(t *testing.T) {
+	var regions []string
+	newResource := func() *schema.Resource {
+		return &schema.Resource{
+			Create: func(d *schema.ResourceData, meta interface{}) error {
+				regions = append(regions, meta.(*AWSClient).region)
+				d.SetId("id")
+				return nil
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				regions = append(regions, meta.(*AWSClient).region)
+				return d.Set("region", "eu-central-1")
+			},
+			Delete: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		}
+	}
+
+	client := &AWSClient{
+		region: "us-west-2",
+		regionalClients: &awsRegionalClients{
+			clients: make(map[string]*AWSClient),
+			init:    func(*AWSClient, string) {},
+		},
+	}
+
+	r := newResource()
+	providerRegionResource("aws_s3_bucket", r)
+	if r.Schema["region"].ForceNew {
+		t.Fatal("Expected the region attribute of aws_s3_bucket to be kept")
+	}
+
+	d := r.TestResourceData()
+	d.Set("region", "eu-west-1")
+	if err := r.Create(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Read(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("region").(string); v != "eu-central-1" {
+		t.Fatalf("Expected the region read by the resource in state, got %q", v)
+	}
+
+	expected := []string{"eu-west-1", "eu-west-1"}
+	if len(regions) != len(expected) || regions[0] != expected[0] || regions[1] != expected[1] {
+		t.Fatalf("Expected calls for regions %q, got %q", expected, regions)
+	}
+
+	regions = nil
+	r = newResource()
+	providerRegionResource("aws_opsworks_stack", r)
+	d = r.TestResourceData()
+	d.Set("region", "eu-west-1")
+	if err := r.Create(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if len(regions) != 1 || regions[0] != "us-west-2" {
+		t.Fatalf("Expected aws_opsworks_stack to use the provider's region, got %q", regions)
+	}
+}
+
+func TestProviderRegionResource_import(t *testing.T) {
+	var regions []string
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			regions = append(regions, meta.(*AWSClient).region)
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				regions = append(regions, meta.(*AWSClient).region)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+	providerRegionResource("aws_test", r)
+
+	client := &AWSClient{
+		region: "us-west-2",
+		regionalClients: &awsRegionalClients{
+			clients: make(map[string]*AWSClient),
+			init:    func(*AWSClient, string) {},
+		},
+	}
+
+	cases := []struct {
+		ImportId string
+		Id       string
+		Region   string
+	}{
+		{"vpc-12345678", "vpc-12345678", "us-west-2"},
+		{"vpc-12345678@eu-central-1", "vpc-12345678", "eu-central-1"},
+		{"rtb-12345678_0.0.0.0/0@us-gov-west-1", "rtb-12345678_0.0.0.0/0", "us-gov-west-1"},
+		{"user@example.com", "user@example.com", "us-west-2"},
+	}
+
+	for _, tc := range cases {
+		regions = nil
+		d := r.TestResourceData()
+		d.SetId(tc.ImportId)
+		results, err := r.Importer.State(d, client)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.ImportId, err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("%s: Expected 1 result, got %d", tc.ImportId, len(results))
+		}
+
+		d = results[0]
+		if d.Id() != tc.Id {
+			t.Fatalf("%s: Expected ID %q, got %q", tc.ImportId, tc.Id, d.Id())
+		}
+		if v := d.Get("region").(string); v != tc.Region {
+			t.Fatalf("%s: Expected region %q in state, got %q", tc.ImportId, tc.Region, v)
+		}
+
+		if err := r.Read(d, client); err != nil {
+			t.Fatal(err)
+		}
+		if len(regions) != 2 || regions[0] != tc.Region || regions[1] != tc.Region {
+			t.Fatalf("%s: Expected calls for region %q, got %q", tc.ImportId, tc.Region, regions)
+		}
+	}
+
+	client.forbiddenRegions = []string{"eu-*"}
+	d := r.TestResourceData()
+	d.SetId("vpc-12345678@eu-central-1")
+	if _, err := r.Importer.State(d, client); err == nil {
+		t.Fatal("Expected an error importing into a forbidden region")
+	}
+}
+
+func TestValidateRegionAllowed(t *testing.T) {
+	cases := []struct {
+		Region    string
+		Allowed   []string
+		Forbidden []string
+		Valid     bool
+	}{
+		{"us-east-1", nil, nil, true},
+		{"eu-west-1", []string{"eu-*"}, nil, true},
+		{"us-east-1", []string{"eu-*"}, nil, false},
+		{"us-east-1", []string{"eu-*", "us-east-1"}, nil, true},
+		{"us-east-1", nil, []string{"us-*"}, false},
+		{"eu-north-1", []string{"eu-*"}, []string{"eu-north-?"}, false},
+		{"eu-west-1", []string{"eu-*"}, []string{"eu-north-?"}, true},
+	}
+
+	for _, tc := range cases {
+		err := validateRegionAllowed(tc.Region, tc.Allowed, tc.Forbidden)
+		if tc.Valid && err != nil {
+			t.Errorf("%s (allowed %q, forbidden %q): %s", tc.Region, tc.Allowed, tc.Forbidden, err)
+		}
+		if !tc.Valid && err == nil {
+			t.Errorf("%s (allowed %q, forbidden %q): expected an error", tc.Region, tc.Allowed, tc.Forbidden)
+		}
+	}
+}
+
+func TestProviderRegionGuardResource(t *testing.T) {
+	var created int
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			created++
+			d.SetId("id")
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+	providerRegionGuardResource(r)
+
+	client := &AWSClient{
+		region:         "eu-west-1",
+		allowedRegions: []string{"eu-*"},
+	}
+
+	cases := []struct {
+		KeyId   string
+		RoleArn string
+		Arn     string
+		Valid   bool
+	}{
+		{
+			KeyId:   "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			RoleArn: "arn:aws:iam::123456789012:role/target",
+			Valid:   true,
+		},
+		{
+			KeyId: "1234abcd-12ab-34cd-56ef-1234567890ab",
+			Valid: true,
+		},
+		{
+			KeyId: "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+		},
+		{
+			RoleArn: "arn:aws:sqs:us-east-1:123456789012:queue",
+		},
+		{
+			Arn:   "arn:aws:sqs:us-east-1:123456789012:queue",
+			Valid: true,
+		},
+	}
+
+	for i, tc := range cases {
+		created = 0
+		d := r.TestResourceData()
+		d.Set("kms_key_id", tc.KeyId)
+		d.Set("arn", tc.Arn)
+		if tc.RoleArn != "" {
+			d.Set("target", []interface{}{map[string]interface{}{"role_arn": tc.RoleArn}})
+		}
+
+		err := r.Create(d, client)
+		if tc.Valid && (err != nil || created != 1) {
+			t.Fatalf("%d: expected the resource to be created, got error: %v", i, err)
+		}
+		if !tc.Valid && (err == nil || created != 0) {
+			t.Fatalf("%d: expected an error for an ARN in a region that isn't allowed", i)
+		}
+	}
+}
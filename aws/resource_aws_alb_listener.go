@@ -32,9 +32,10 @@ func resourceAwsAlbListener() *schema.Resource {
 			},
 
 			"load_balancer_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOf("elasticloadbalancing", "loadbalancer"),
 			},
 
 			"port": {
@@ -60,8 +61,9 @@ func resourceAwsAlbListener() *schema.Resource {
 			},
 
 			"certificate_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnOfServices("acm", "iam"),
 			},
 
 			"default_action": {
@@ -70,8 +72,9 @@ func resourceAwsAlbListener() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_group_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLbTargetGroupArn,
 						},
 						"type": {
 							Type:         schema.TypeString,
@@ -85,6 +88,14 @@ func resourceAwsAlbListener() *schema.Resource {
 	}
 }
 
+// resourceAwsAlbListenerCustomizeDiff checks the certificate of a listener
+// against its protocol at plan time.
+func resourceAwsAlbListenerCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		resourceAwsAlbListenerValidateCertificate,
+	)
+}
+
 func resourceAwsAlbListenerCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
@@ -282,3 +293,23 @@ func isListenerNotFound(err error) bool {
 	elberr, ok := err.(awserr.Error)
 	return ok && elberr.Code() == "ListenerNotFound"
 }
+
+// resourceAwsAlbListenerValidateCertificate checks that a certificate is
+// given for HTTPS listeners, and only for them.
+func resourceAwsAlbListenerValidateCertificate(d *resourceDiff) error {
+	if !d.NewValueKnown("protocol") {
+		return nil
+	}
+
+	switch strings.ToUpper(d.Get("protocol").(string)) {
+	case "HTTPS":
+		if !diffValueSet(d, "certificate_arn") {
+			return fmt.Errorf("%q: required field is not set for HTTPS listeners", "certificate_arn")
+		}
+	case "HTTP":
+		if _, ok := d.GetOk("certificate_arn"); ok {
+			return fmt.Errorf("%q: can only be set for HTTPS listeners", "certificate_arn")
+		}
+	}
+	return nil
+}
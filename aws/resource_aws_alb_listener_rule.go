@@ -30,9 +30,10 @@ func resourceAwsAlbListenerRule() *schema.Resource {
 				Computed: true,
 			},
 			"listener_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOf("elasticloadbalancing", "listener"),
 			},
 			"priority": {
 				Type:         schema.TypeInt,
@@ -46,8 +47,9 @@ func resourceAwsAlbListenerRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_group_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLbTargetGroupArn,
 						},
 						"type": {
 							Type:         schema.TypeString,
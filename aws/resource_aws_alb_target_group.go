@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -178,6 +179,15 @@ func resourceAwsAlbTargetGroup() *schema.Resource {
 	}
 }
 
+// resourceAwsAlbTargetGroupCustomizeDiff checks the health check settings of
+// a target group against each other and against its protocol at plan time.
+func resourceAwsAlbTargetGroupCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		resourceAwsAlbTargetGroupValidateHealthCheck,
+		resourceAwsAlbTargetGroupValidateHealthCheckProtocol,
+	)
+}
+
 func resourceAwsAlbTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
@@ -541,3 +551,59 @@ func flattenAwsAlbTargetGroupResource(d *schema.ResourceData, meta interface{},
 
 	return nil
 }
+
+// resourceAwsAlbTargetGroupValidateHealthCheck checks that a health check
+// times out before the next one is due.
+func resourceAwsAlbTargetGroupValidateHealthCheck(d *resourceDiff) error {
+	if !d.NewValueKnown("health_check.0.interval") || !d.NewValueKnown("health_check.0.timeout") {
+		return nil
+	}
+
+	healthChecks := d.Get("health_check").([]interface{})
+	if len(healthChecks) != 1 {
+		return nil
+	}
+	healthCheck, ok := healthChecks[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	interval := healthCheck["interval"].(int)
+	timeout := healthCheck["timeout"].(int)
+	if timeout >= interval {
+		return fmt.Errorf("health_check: timeout (%d) must be smaller than the interval (%d)", timeout, interval)
+	}
+	return nil
+}
+
+// resourceAwsAlbTargetGroupValidateHealthCheckProtocol checks that TCP target
+// groups use neither HTTP nor HTTPS health checks, nor the path and matcher
+// settings that only apply to those.
+func resourceAwsAlbTargetGroupValidateHealthCheckProtocol(d *resourceDiff) error {
+	if !d.NewValueKnown("protocol") || strings.ToUpper(d.Get("protocol").(string)) != "TCP" {
+		return nil
+	}
+
+	healthChecks := d.Get("health_check").([]interface{})
+	if len(healthChecks) != 1 {
+		return nil
+	}
+	healthCheck, ok := healthChecks[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var errs *multierror.Error
+	if d.NewValueKnown("health_check.0.protocol") {
+		switch protocol := strings.ToUpper(healthCheck["protocol"].(string)); protocol {
+		case "HTTP", "HTTPS":
+			errs = multierror.Append(errs, fmt.Errorf("health_check: %s health checks can't be used with TCP target groups", protocol))
+		}
+	}
+	for _, key := range []string{"path", "matcher"} {
+		if diffValueConfigured(d, "health_check.0."+key) {
+			errs = multierror.Append(errs, fmt.Errorf("health_check: %q can't be set for TCP target groups", key))
+		}
+	}
+	return errs.ErrorOrNil()
+}
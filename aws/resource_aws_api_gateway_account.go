@@ -23,8 +23,9 @@ func resourceAwsApiGatewayAccount() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cloudwatch_role_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"throttle_settings": &schema.Schema{
 				Type:     schema.TypeList,
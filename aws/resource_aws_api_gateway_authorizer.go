@@ -18,6 +18,10 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 		Update: resourceAwsApiGatewayAuthorizerUpdate,
 		Delete: resourceAwsApiGatewayAuthorizerDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayAuthorizerImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"authorizer_uri": &schema.Schema{
 				Type:     schema.TypeString,
@@ -59,6 +63,16 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayAuthorizerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/AUTHORIZER-ID", d.Id())
+	}
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayAuthorizerCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,10 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 		Read:   resourceAwsApiGatewayBasePathMappingRead,
 		Delete: resourceAwsApiGatewayBasePathMappingDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayBasePathMappingImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -45,6 +50,18 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayBasePathMappingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The base path is empty for a mapping of the root of the domain,
+	// i.e. the ID is "DOMAIN-NAME/"
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected DOMAIN-NAME/BASE-PATH", d.Id())
+	}
+	d.Set("domain_name", idParts[0])
+	d.Set("base_path", idParts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayBasePathMappingCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 		Update: resourceAwsApiGatewayDeploymentUpdate,
 		Delete: resourceAwsApiGatewayDeploymentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayDeploymentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -68,6 +73,36 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayDeploymentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/DEPLOYMENT-ID", d.Id())
+	}
+	restApiId, deploymentId := idParts[0], idParts[1]
+
+	// The stage the deployment was created with isn't part of the
+	// deployment itself, look for the stage that still points at it.
+	conn := meta.(*AWSClient).apigateway
+	out, err := conn.GetStages(&apigateway.GetStagesInput{
+		RestApiId:    aws.String(restApiId),
+		DeploymentId: aws.String(deploymentId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading API Gateway Stages for Deployment %s: %s", deploymentId, err)
+	}
+	if len(out.Item) == 0 {
+		return nil, fmt.Errorf("No API Gateway Stage found for Deployment %s", deploymentId)
+	}
+	stage := out.Item[0]
+
+	d.Set("rest_api_id", restApiId)
+	d.Set("stage_name", stage.StageName)
+	d.Set("stage_description", stage.Description)
+	d.Set("variables", aws.StringValueMap(stage.Variables))
+	d.SetId(deploymentId)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	// Create the gateway
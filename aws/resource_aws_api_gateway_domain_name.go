@@ -19,6 +19,10 @@ func resourceAwsApiGatewayDomainName() *schema.Resource {
 		Update: resourceAwsApiGatewayDomainNameUpdate,
 		Delete: resourceAwsApiGatewayDomainNameDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			//According to AWS Documentation, ACM will be the only way to add certificates
@@ -62,6 +66,7 @@ func resourceAwsApiGatewayDomainName() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"certificate_body", "certificate_chain", "certificate_name", "certificate_private_key"},
+				ValidateFunc:  validateArnOf("acm", "certificate"),
 			},
 
 			"cloudfront_domain_name": {
@@ -21,6 +21,10 @@ func resourceAwsApiGatewayIntegration() *schema.Resource {
 		Update: resourceAwsApiGatewayIntegrationUpdate,
 		Delete: resourceAwsApiGatewayIntegrationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayIntegrationImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -118,6 +122,19 @@ func resourceAwsApiGatewayIntegration() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayIntegrationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD", d.Id())
+	}
+	restApiId, resourceId, httpMethod := idParts[0], idParts[1], idParts[2]
+	d.Set("rest_api_id", restApiId)
+	d.Set("resource_id", resourceId)
+	d.Set("http_method", httpMethod)
+	d.SetId(fmt.Sprintf("agi-%s-%s-%s", restApiId, resourceId, httpMethod))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -227,6 +244,7 @@ func resourceAwsApiGatewayIntegrationRead(d *schema.ResourceData, meta interface
 
 	d.Set("request_templates", aws.StringValueMap(integration.RequestTemplates))
 	d.Set("type", integration.Type)
+	d.Set("integration_http_method", integration.HttpMethod)
 	d.Set("request_parameters", aws.StringValueMap(integration.RequestParameters))
 	d.Set("request_parameters_in_json", aws.StringValueMap(integration.RequestParameters))
 	d.Set("passthrough_behavior", integration.PassthroughBehavior)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,10 @@ func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
 		Update: resourceAwsApiGatewayIntegrationResponseCreate,
 		Delete: resourceAwsApiGatewayIntegrationResponseDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayIntegrationResponseImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -79,6 +84,20 @@ func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayIntegrationResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE", d.Id())
+	}
+	restApiId, resourceId, httpMethod, statusCode := idParts[0], idParts[1], idParts[2], idParts[3]
+	d.Set("rest_api_id", restApiId)
+	d.Set("resource_id", resourceId)
+	d.Set("http_method", httpMethod)
+	d.Set("status_code", statusCode)
+	d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", restApiId, resourceId, httpMethod, statusCode))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayIntegrationResponseCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -150,6 +169,7 @@ func resourceAwsApiGatewayIntegrationResponseRead(d *schema.ResourceData, meta i
 	d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", d.Get("rest_api_id").(string), d.Get("resource_id").(string), d.Get("http_method").(string), d.Get("status_code").(string)))
 	d.Set("response_templates", integrationResponse.ResponseTemplates)
 	d.Set("selection_pattern", integrationResponse.SelectionPattern)
+	d.Set("content_handling", integrationResponse.ContentHandling)
 	d.Set("response_parameters", aws.StringValueMap(integrationResponse.ResponseParameters))
 	d.Set("response_parameters_in_json", aws.StringValueMap(integrationResponse.ResponseParameters))
 	return nil
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -21,6 +22,10 @@ func resourceAwsApiGatewayMethod() *schema.Resource {
 		Update: resourceAwsApiGatewayMethodUpdate,
 		Delete: resourceAwsApiGatewayMethodDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayMethodImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -85,6 +90,19 @@ func resourceAwsApiGatewayMethod() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayMethodImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD", d.Id())
+	}
+	restApiId, resourceId, httpMethod := idParts[0], idParts[1], idParts[2]
+	d.Set("rest_api_id", restApiId)
+	d.Set("resource_id", resourceId)
+	d.Set("http_method", httpMethod)
+	d.SetId(fmt.Sprintf("agm-%s-%s-%s", restApiId, resourceId, httpMethod))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayMethodCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -162,7 +180,7 @@ func resourceAwsApiGatewayMethodRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("request_parameters", aws.BoolValueMap(out.RequestParameters))
 	d.Set("request_parameters_in_json", aws.BoolValueMap(out.RequestParameters))
 	d.Set("api_key_required", out.ApiKeyRequired)
-	d.Set("authorization_type", out.AuthorizationType)
+	d.Set("authorization", out.AuthorizationType)
 	d.Set("authorizer_id", out.AuthorizerId)
 	d.Set("request_models", aws.StringValueMap(out.RequestModels))
 	d.Set("request_validator_id", out.RequestValidatorId)
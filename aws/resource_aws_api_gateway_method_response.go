@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -21,6 +22,10 @@ func resourceAwsApiGatewayMethodResponse() *schema.Resource {
 		Update: resourceAwsApiGatewayMethodResponseUpdate,
 		Delete: resourceAwsApiGatewayMethodResponseDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayMethodResponseImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -69,6 +74,20 @@ func resourceAwsApiGatewayMethodResponse() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayMethodResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE", d.Id())
+	}
+	restApiId, resourceId, httpMethod, statusCode := idParts[0], idParts[1], idParts[2], idParts[3]
+	d.Set("rest_api_id", restApiId)
+	d.Set("resource_id", resourceId)
+	d.Set("http_method", httpMethod)
+	d.Set("status_code", statusCode)
+	d.SetId(fmt.Sprintf("agmr-%s-%s-%s-%s", restApiId, resourceId, httpMethod, statusCode))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayMethodResponseCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
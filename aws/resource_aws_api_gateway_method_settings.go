@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -17,6 +18,10 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 		Update: resourceAwsApiGatewayMethodSettingsUpdate,
 		Delete: resourceAwsApiGatewayMethodSettingsDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayMethodSettingsImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -86,6 +91,20 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayMethodSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The method path itself contains a slash, e.g. "*/*" or "path/GET"
+	idParts := strings.SplitN(d.Id(), "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME/METHOD-PATH", d.Id())
+	}
+	restApiId, stageName, methodPath := idParts[0], idParts[1], idParts[2]
+	d.Set("rest_api_id", restApiId)
+	d.Set("stage_name", stageName)
+	d.Set("method_path", methodPath)
+	d.SetId(restApiId + "-" + stageName + "-" + methodPath)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayMethodSettingsRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -113,20 +132,30 @@ func resourceAwsApiGatewayMethodSettingsRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	d.Set("settings.0.metrics_enabled", settings.MetricsEnabled)
-	d.Set("settings.0.logging_level", settings.LoggingLevel)
-	d.Set("settings.0.data_trace_enabled", settings.DataTraceEnabled)
-	d.Set("settings.0.throttling_burst_limit", settings.ThrottlingBurstLimit)
-	d.Set("settings.0.throttling_rate_limit", settings.ThrottlingRateLimit)
-	d.Set("settings.0.caching_enabled", settings.CachingEnabled)
-	d.Set("settings.0.cache_ttl_in_seconds", settings.CacheTtlInSeconds)
-	d.Set("settings.0.cache_data_encrypted", settings.CacheDataEncrypted)
-	d.Set("settings.0.require_authorization_for_cache_control", settings.RequireAuthorizationForCacheControl)
-	d.Set("settings.0.unauthorized_cache_control_header_strategy", settings.UnauthorizedCacheControlHeaderStrategy)
+	if err := d.Set("settings", flattenAwsApiGatewayMethodSettings(settings)); err != nil {
+		return fmt.Errorf("Error setting settings: %s", err)
+	}
 
 	return nil
 }
 
+func flattenAwsApiGatewayMethodSettings(settings *apigateway.MethodSetting) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"metrics_enabled":                            aws.BoolValue(settings.MetricsEnabled),
+			"logging_level":                              aws.StringValue(settings.LoggingLevel),
+			"data_trace_enabled":                         aws.BoolValue(settings.DataTraceEnabled),
+			"throttling_burst_limit":                     int(aws.Int64Value(settings.ThrottlingBurstLimit)),
+			"throttling_rate_limit":                      aws.Float64Value(settings.ThrottlingRateLimit),
+			"caching_enabled":                            aws.BoolValue(settings.CachingEnabled),
+			"cache_ttl_in_seconds":                       int(aws.Int64Value(settings.CacheTtlInSeconds)),
+			"cache_data_encrypted":                       aws.BoolValue(settings.CacheDataEncrypted),
+			"require_authorization_for_cache_control":    aws.BoolValue(settings.RequireAuthorizationForCacheControl),
+			"unauthorized_cache_control_header_strategy": aws.StringValue(settings.UnauthorizedCacheControlHeaderStrategy),
+		},
+	}
+}
+
 func resourceAwsApiGatewayMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
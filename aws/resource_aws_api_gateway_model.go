@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsApiGatewayModel() *schema.Resource {
 		Update: resourceAwsApiGatewayModelUpdate,
 		Delete: resourceAwsApiGatewayModelDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayModelImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -51,6 +56,18 @@ func resourceAwsApiGatewayModel() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayModelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/MODEL-NAME", d.Id())
+	}
+	// Read replaces the ID with the one of the model
+	d.Set("rest_api_id", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(idParts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayModelCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Creating API Gateway Model")
@@ -17,6 +17,10 @@ func resourceAwsApiGatewayRequestValidator() *schema.Resource {
 		Update: resourceAwsApiGatewayRequestValidatorUpdate,
 		Delete: resourceAwsApiGatewayRequestValidatorDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayRequestValidatorImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -44,6 +48,16 @@ func resourceAwsApiGatewayRequestValidator() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayRequestValidatorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/REQUEST-VALIDATOR-ID", d.Id())
+	}
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayRequestValidatorCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsApiGatewayResource() *schema.Resource {
 		Update: resourceAwsApiGatewayResourceUpdate,
 		Delete: resourceAwsApiGatewayResourceDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayResourceImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -44,6 +49,16 @@ func resourceAwsApiGatewayResource() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayResourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID", d.Id())
+	}
+	d.Set("rest_api_id", idParts[0])
+	d.SetId(idParts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayResourceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Creating API Gateway Resource for API %s", d.Get("rest_api_id").(string))
@@ -20,6 +20,10 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 		Update: resourceAwsApiGatewayRestApiUpdate,
 		Delete: resourceAwsApiGatewayRestApiDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -139,6 +143,13 @@ func resourceAwsApiGatewayRestApiRead(d *schema.ResourceData, meta interface{})
 	d.Set("description", api.Description)
 	d.Set("binary_media_types", api.BinaryMediaTypes)
 
+	// root_resource_id is only known after create, or import
+	if d.Get("root_resource_id").(string) == "" {
+		if err := resourceAwsApiGatewayRestApiRefreshResources(d, meta); err != nil {
+			return err
+		}
+	}
+
 	if err := d.Set("created_date", api.CreatedDate.Format(time.RFC3339)); err != nil {
 		log.Printf("[DEBUG] Error setting created_date: %s", err)
 	}
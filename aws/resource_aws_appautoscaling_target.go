@@ -36,9 +36,10 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 				ForceNew: true,
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"scalable_dimension": {
 				Type:         schema.TypeString,
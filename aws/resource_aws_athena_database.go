@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAthenaDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAthenaDatabaseCreate,
+		Read:   resourceAwsAthenaDatabaseRead,
+		Update: resourceAwsAthenaDatabaseUpdate,
+		Delete: resourceAwsAthenaDatabaseDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAthenaDatabaseName,
+			},
+
+			// The bucket and the encryption options only apply to the results
+			// of the DDL queries run by Terraform, so they're updated in place.
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_option": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								athena.EncryptionOptionSseS3,
+								athena.EncryptionOptionSseKms,
+								athena.EncryptionOptionCseKms,
+							}, false),
+						},
+						"kms_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// resourceAwsAthenaDatabaseCustomizeDiff checks the encryption settings of a
+// database at plan time.
+func resourceAwsAthenaDatabaseCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		resourceAwsAthenaDatabaseValidateEncryption,
+	)
+}
+
+func resourceAwsAthenaDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Creating Athena Database: %s", name)
+
+	_, err := athenaExecuteQuery(conn, fmt.Sprintf("CREATE DATABASE `%s`;", name), athenaDatabaseResultConfiguration(d))
+	if err != nil {
+		return errwrap.Wrapf("Error creating Athena Database: {{err}}", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsAthenaDatabaseRead(d, meta)
+}
+
+func resourceAwsAthenaDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+	log.Printf("[DEBUG] Reading Athena Database: %s", d.Id())
+
+	// Athena has no API to describe a database, so it is looked up in the
+	// results of a query listing all of them.
+	id, err := athenaExecuteQuery(conn, "SHOW DATABASES;", athenaDatabaseResultConfiguration(d))
+	if err != nil {
+		return errwrap.Wrapf("Error listing Athena Databases: {{err}}", err)
+	}
+
+	found := false
+	err = conn.GetQueryResultsPages(&athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(id),
+	}, func(page *athena.GetQueryResultsOutput, lastPage bool) bool {
+		for _, row := range page.ResultSet.Rows {
+			for _, datum := range row.Data {
+				if aws.StringValue(datum.VarCharValue) == d.Id() {
+					found = true
+					return false
+				}
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error reading Athena Databases: {{err}}", err)
+	}
+
+	if !found {
+		log.Printf("[WARN] Athena Database %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+
+	return nil
+}
+
+func resourceAwsAthenaDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsAthenaDatabaseRead(d, meta)
+}
+
+func resourceAwsAthenaDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+	log.Printf("[DEBUG] Deleting Athena Database: %s", d.Id())
+
+	query := fmt.Sprintf("DROP DATABASE `%s`", d.Id())
+	if d.Get("force_destroy").(bool) {
+		// CASCADE drops the tables of the database along with it.
+		query += " CASCADE"
+	}
+	query += ";"
+
+	_, err := athenaExecuteQuery(conn, query, athenaDatabaseResultConfiguration(d))
+	if err != nil {
+		return errwrap.Wrapf("Error deleting Athena Database: {{err}}", err)
+	}
+
+	return nil
+}
+
+func athenaDatabaseResultConfiguration(d *schema.ResourceData) *athena.ResultConfiguration {
+	resultConfig := &athena.ResultConfiguration{
+		OutputLocation: aws.String("s3://" + d.Get("bucket").(string)),
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok {
+		config := v.([]interface{})[0].(map[string]interface{})
+		resultConfig.EncryptionConfiguration = &athena.EncryptionConfiguration{
+			EncryptionOption: aws.String(config["encryption_option"].(string)),
+		}
+		if v, ok := config["kms_key"]; ok && v.(string) != "" {
+			resultConfig.EncryptionConfiguration.KmsKey = aws.String(v.(string))
+		}
+	}
+
+	return resultConfig
+}
+
+// athenaExecuteQuery runs a query and waits for it to complete, returning
+// the ID of the query execution from which its results can be read.
+func athenaExecuteQuery(conn *athena.Athena, query string, resultConfig *athena.ResultConfiguration) (string, error) {
+	resp, err := conn.StartQueryExecution(&athena.StartQueryExecutionInput{
+		QueryString:         aws.String(query),
+		ResultConfiguration: resultConfig,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	id := *resp.QueryExecutionId
+	log.Printf("[DEBUG] Waiting for Athena Query Execution %s to complete", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			athena.QueryExecutionStateQueued,
+			athena.QueryExecutionStateRunning,
+		},
+		Target: []string{
+			athena.QueryExecutionStateSucceeded,
+		},
+		Refresh:    athenaQueryExecutionStateRefreshFunc(conn, id),
+		Timeout:    10 * time.Minute,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func athenaQueryExecutionStateRefreshFunc(conn *athena.Athena, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetQueryExecution(&athena.GetQueryExecutionInput{
+			QueryExecutionId: aws.String(id),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := resp.QueryExecution.Status
+		state := aws.StringValue(status.State)
+		switch state {
+		case athena.QueryExecutionStateFailed, athena.QueryExecutionStateCancelled:
+			return nil, state, fmt.Errorf("Athena Query Execution %s %s: %s",
+				id, strings.ToLower(state), aws.StringValue(status.StateChangeReason))
+		}
+
+		return resp.QueryExecution, state, nil
+	}
+}
+
+// resourceAwsAthenaDatabaseValidateEncryption checks that a KMS key is given
+// for the encryption options using KMS.
+func resourceAwsAthenaDatabaseValidateEncryption(d *resourceDiff) error {
+	if !d.NewValueKnown("encryption_configuration.0.encryption_option") {
+		return nil
+	}
+
+	switch d.Get("encryption_configuration.0.encryption_option").(string) {
+	case athena.EncryptionOptionSseKms, athena.EncryptionOptionCseKms:
+		if !diffValueSet(d, "encryption_configuration.0.kms_key") {
+			return fmt.Errorf("%q: required field is not set when encryption_option is %s",
+				"encryption_configuration.0.kms_key", d.Get("encryption_configuration.0.encryption_option"))
+		}
+	}
+
+	return nil
+}
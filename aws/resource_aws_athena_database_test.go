@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAthenaDatabase_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAthenaDatabaseConfig(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaDatabaseExists("aws_athena_database.test"),
+					resource.TestCheckResourceAttr("aws_athena_database.test", "name", fmt.Sprintf("tf_athena_db_%d", rInt)),
+					resource.TestCheckResourceAttr("aws_athena_database.test", "force_destroy", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaDatabase_encryption(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAthenaDatabaseConfigEncryption(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaDatabaseExists("aws_athena_database.test"),
+					resource.TestCheckResourceAttr("aws_athena_database.test", "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_athena_database.test", "encryption_configuration.0.encryption_option", "SSE_KMS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaDatabase_forceDestroy(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAthenaDatabaseConfig(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaDatabaseExists("aws_athena_database.test"),
+					testAccAWSAthenaDatabaseCreateTable("aws_athena_database.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAthenaDatabaseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Database ID is set")
+		}
+
+		found, err := testAccAWSAthenaDatabaseFound(rs.Primary.ID, rs.Primary.Attributes["bucket"])
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Athena Database %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAWSAthenaDatabaseDestroy lists the databases with a temporary
+// bucket, as the one the database used is destroyed along with it.
+func testAccCheckAWSAthenaDatabaseDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+	bucket := fmt.Sprintf("tf-athena-db-destroy-%d", acctest.RandInt())
+
+	_, err := conn.CreateBucket(&s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return err
+	}
+	defer testAccAWSAthenaDeleteBucket(bucket)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_database" {
+			continue
+		}
+
+		found, err := testAccAWSAthenaDatabaseFound(rs.Primary.ID, bucket)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("Athena Database %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAthenaDatabaseFound(name, bucket string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+	id, err := athenaExecuteQuery(conn, "SHOW DATABASES;", &athena.ResultConfiguration{
+		OutputLocation: aws.String("s3://" + bucket),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := conn.GetQueryResults(&athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(id),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, row := range resp.ResultSet.Rows {
+		for _, datum := range row.Data {
+			if aws.StringValue(datum.VarCharValue) == name {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// testAccAWSAthenaDatabaseCreateTable adds a table to the database, which
+// can then only be destroyed with force_destroy.
+func testAccAWSAthenaDatabaseCreateTable(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).athenaconn
+		bucket := rs.Primary.Attributes["bucket"]
+
+		query := fmt.Sprintf("CREATE EXTERNAL TABLE `%s`.`test` (id int) LOCATION 's3://%s/test/';", rs.Primary.ID, bucket)
+		_, err := athenaExecuteQuery(conn, query, &athena.ResultConfiguration{
+			OutputLocation: aws.String("s3://" + bucket),
+		})
+		return err
+	}
+}
+
+func testAccAWSAthenaDeleteBucket(bucket string) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	err := conn.ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+	}, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			conn.DeleteObject(&s3.DeleteObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    object.Key,
+			})
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	return err
+}
+
+func testAccAWSAthenaDatabaseConfig(rInt int, forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = "tf-athena-db-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_athena_database" "test" {
+  name          = "tf_athena_db_%[1]d"
+  bucket        = "${aws_s3_bucket.test.bucket}"
+  force_destroy = %[2]t
+}
+`, rInt, forceDestroy)
+}
+
+func testAccAWSAthenaDatabaseConfigEncryption(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  description             = "Athena database %[1]d"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = "tf-athena-db-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_athena_database" "test" {
+  name   = "tf_athena_db_%[1]d"
+  bucket = "${aws_s3_bucket.test.bucket}"
+
+  encryption_configuration {
+    encryption_option = "SSE_KMS"
+    kms_key           = "${aws_kms_key.test.arn}"
+  }
+}
+`, rInt)
+}
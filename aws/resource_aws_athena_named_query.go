@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAthenaNamedQuery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAthenaNamedQueryCreate,
+		Read:   resourceAwsAthenaNamedQueryRead,
+		Delete: resourceAwsAthenaNamedQueryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Named queries can't be updated, so all of the arguments force a new
+		// named query to be created.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"database": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceAwsAthenaNamedQueryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	params := &athena.CreateNamedQueryInput{
+		Name:        aws.String(d.Get("name").(string)),
+		Database:    aws.String(d.Get("database").(string)),
+		QueryString: aws.String(d.Get("query").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Athena Named Query: %s", params)
+
+	resp, err := conn.CreateNamedQuery(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Athena Named Query: {{err}}", err)
+	}
+
+	d.SetId(*resp.NamedQueryId)
+
+	return resourceAwsAthenaNamedQueryRead(d, meta)
+}
+
+func resourceAwsAthenaNamedQueryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+	log.Printf("[DEBUG] Reading Athena Named Query: %s", d.Id())
+
+	resp, err := conn.GetNamedQuery(&athena.GetNamedQueryInput{
+		NamedQueryId: aws.String(d.Id()),
+	})
+	if err != nil {
+		// Athena reports unknown named queries as invalid requests.
+		if isAWSErr(err, athena.ErrCodeInvalidRequestException, d.Id()) {
+			log.Printf("[WARN] Athena Named Query %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", resp.NamedQuery.Name)
+	d.Set("query", resp.NamedQuery.QueryString)
+	d.Set("database", resp.NamedQuery.Database)
+	d.Set("description", resp.NamedQuery.Description)
+
+	return nil
+}
+
+func resourceAwsAthenaNamedQueryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+	log.Printf("[DEBUG] Deleting Athena Named Query: %s", d.Id())
+
+	_, err := conn.DeleteNamedQuery(&athena.DeleteNamedQueryInput{
+		NamedQueryId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, athena.ErrCodeInvalidRequestException, d.Id()) {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Athena Named Query: {{err}}", err)
+	}
+
+	return nil
+}
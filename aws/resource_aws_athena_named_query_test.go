@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAthenaNamedQuery_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaNamedQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAthenaNamedQueryConfig(rInt, "SELECT * FROM test limit 10;"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaNamedQueryExists("aws_athena_named_query.test"),
+					resource.TestCheckResourceAttr("aws_athena_named_query.test", "name", fmt.Sprintf("tf-athena-query-%d", rInt)),
+					resource.TestCheckResourceAttr("aws_athena_named_query.test", "query", "SELECT * FROM test limit 10;"),
+					resource.TestCheckResourceAttr("aws_athena_named_query.test", "description", "tf test"),
+					resource.TestCheckResourceAttrPair("aws_athena_named_query.test", "database", "aws_athena_database.test", "name"),
+				),
+			},
+			{
+				Config: testAccAWSAthenaNamedQueryConfig(rInt, "SELECT * FROM test limit 20;"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaNamedQueryExists("aws_athena_named_query.test"),
+					resource.TestCheckResourceAttr("aws_athena_named_query.test", "query", "SELECT * FROM test limit 20;"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAthenaNamedQueryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Named Query ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+		_, err := conn.GetNamedQuery(&athena.GetNamedQueryInput{
+			NamedQueryId: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSAthenaNamedQueryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_named_query" {
+			continue
+		}
+
+		_, err := conn.GetNamedQuery(&athena.GetNamedQueryInput{
+			NamedQueryId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, athena.ErrCodeInvalidRequestException, rs.Primary.ID) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Athena Named Query %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAthenaNamedQueryConfig(rInt int, query string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = "tf-athena-db-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_athena_database" "test" {
+  name   = "tf_athena_db_%[1]d"
+  bucket = "${aws_s3_bucket.test.bucket}"
+}
+
+resource "aws_athena_named_query" "test" {
+  name        = "tf-athena-query-%[1]d"
+  database    = "${aws_athena_database.test.name}"
+  query       = %[2]q
+  description = "tf test"
+}
+`, rInt, query)
+}
@@ -202,8 +202,11 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateLbTargetGroupArn,
+				},
+				Set: schema.HashString,
 			},
 
 			"arn": {
@@ -238,12 +241,14 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 							Optional: true,
 						},
 						"notification_target_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOfServices("sns", "sqs"),
 						},
 						"role_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -261,6 +266,14 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 	}
 }
 
+// resourceAwsAutoscalingGroupCustomizeDiff checks the sizes of a group at
+// plan time.
+func resourceAwsAutoscalingGroupCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		resourceAwsAutoscalingGroupValidateSize,
+	)
+}
+
 func generatePutLifecycleHookInputs(asgName string, cfgs []interface{}) []autoscaling.PutLifecycleHookInput {
 	res := make([]autoscaling.PutLifecycleHookInput, 0, len(cfgs))
 
@@ -1043,3 +1056,27 @@ func expandVpcZoneIdentifiers(list []interface{}) *string {
 	}
 	return aws.String(strings.Join(strs, ","))
 }
+
+// resourceAwsAutoscalingGroupValidateSize checks that min_size doesn't exceed
+// max_size, and that a changed desired_capacity is within both.
+func resourceAwsAutoscalingGroupValidateSize(d *resourceDiff) error {
+	if !d.NewValueKnown("min_size") || !d.NewValueKnown("max_size") {
+		return nil
+	}
+
+	minSize := d.Get("min_size").(int)
+	maxSize := d.Get("max_size").(int)
+	if minSize > maxSize {
+		return fmt.Errorf("min_size (%d) must be less than or equal to max_size (%d)", minSize, maxSize)
+	}
+
+	if !d.HasChange("desired_capacity") || !d.NewValueKnown("desired_capacity") {
+		return nil
+	}
+	if v, ok := d.GetOk("desired_capacity"); ok {
+		if desired := v.(int); desired < minSize || desired > maxSize {
+			return fmt.Errorf("desired_capacity (%d) must be between min_size (%d) and max_size (%d)", desired, minSize, maxSize)
+		}
+	}
+	return nil
+}
@@ -48,12 +48,14 @@ func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
 				Optional: true,
 			},
 			"notification_target_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnOfServices("sns", "sqs"),
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 		},
 	}
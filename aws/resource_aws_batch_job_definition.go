@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Job definitions are immutable: every change registers a new revision and
+// deregisters the previous one, so all arguments force a new resource.
+func resourceAwsBatchJobDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBatchJobDefinitionCreate,
+		Read:   resourceAwsBatchJobDefinitionRead,
+		Delete: resourceAwsBatchJobDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchName,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{batch.JobDefinitionTypeContainer}, false),
+			},
+			"container_properties": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateBatchJobContainerProperties,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"retry_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempts": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	input := &batch.RegisterJobDefinitionInput{
+		JobDefinitionName: aws.String(d.Get("name").(string)),
+		Type:              aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("container_properties"); ok {
+		props, err := expandBatchJobContainerProperties(v.(string))
+		if err != nil {
+			return errwrap.Wrapf("Error parsing Batch Job Definition container properties: {{err}}", err)
+		}
+		input.ContainerProperties = props
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("retry_strategy"); ok {
+		input.RetryStrategy = expandBatchJobRetryStrategy(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Registering Batch Job Definition: %s", input)
+	resp, err := conn.RegisterJobDefinition(input)
+	if err != nil {
+		return errwrap.Wrapf("Error registering Batch Job Definition: {{err}}", err)
+	}
+
+	d.SetId(*resp.JobDefinitionArn)
+
+	return resourceAwsBatchJobDefinitionRead(d, meta)
+}
+
+func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	resp, err := conn.DescribeJobDefinitions(&batch.DescribeJobDefinitionsInput{
+		JobDefinitions: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error reading Batch Job Definition: {{err}}", err)
+	}
+
+	if len(resp.JobDefinitions) == 0 || aws.StringValue(resp.JobDefinitions[0].Status) == "INACTIVE" {
+		log.Printf("[WARN] Batch Job Definition %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	jd := resp.JobDefinitions[0]
+
+	containerProperties, err := flattenBatchJobContainerProperties(jd.ContainerProperties)
+	if err != nil {
+		return errwrap.Wrapf("Error reading Batch Job Definition container properties: {{err}}", err)
+	}
+
+	d.Set("name", jd.JobDefinitionName)
+	d.Set("type", jd.Type)
+	d.Set("container_properties", containerProperties)
+	d.Set("parameters", aws.StringValueMap(jd.Parameters))
+	d.Set("retry_strategy", flattenBatchJobRetryStrategy(jd.RetryStrategy))
+	d.Set("arn", jd.JobDefinitionArn)
+	d.Set("revision", jd.Revision)
+
+	return nil
+}
+
+func resourceAwsBatchJobDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	log.Printf("[DEBUG] Deregistering Batch Job Definition %s", d.Id())
+	_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
+		JobDefinition: aws.String(d.Id()),
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error deregistering Batch Job Definition: {{err}}", err)
+	}
+
+	return nil
+}
+
+func expandBatchJobContainerProperties(rawProps string) (*batch.ContainerProperties, error) {
+	props := &batch.ContainerProperties{}
+
+	if err := jsonutil.UnmarshalJSON(props, strings.NewReader(rawProps)); err != nil {
+		return nil, err
+	}
+
+	return props, nil
+}
+
+// flattenBatchJobContainerProperties drops the empty lists AWS fills in for
+// unset properties so the result can be compared with the configuration.
+func flattenBatchJobContainerProperties(props *batch.ContainerProperties) (string, error) {
+	if props == nil {
+		return "", nil
+	}
+
+	if len(props.Command) == 0 {
+		props.Command = nil
+	}
+	if len(props.Environment) == 0 {
+		props.Environment = nil
+	}
+	if len(props.MountPoints) == 0 {
+		props.MountPoints = nil
+	}
+	if len(props.Ulimits) == 0 {
+		props.Ulimits = nil
+	}
+	if len(props.Volumes) == 0 {
+		props.Volumes = nil
+	}
+
+	b, err := jsonutil.BuildJSON(props)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandBatchJobRetryStrategy(l []interface{}) *batch.RetryStrategy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &batch.RetryStrategy{
+		Attempts: aws.Int64(int64(m["attempts"].(int))),
+	}
+}
+
+func flattenBatchJobRetryStrategy(strategy *batch.RetryStrategy) []map[string]interface{} {
+	if strategy == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"attempts": aws.Int64Value(strategy.Attempts),
+		},
+	}
+}
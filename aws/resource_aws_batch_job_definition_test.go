@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSBatchJobDefinition_basic(t *testing.T) {
+	var before, after batch.JobDefinition
+	name := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBatchJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchJobDefinitionConfig(name, 128),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBatchJobDefinitionExists("aws_batch_job_definition.test", &before),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.test", "name", name),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.test", "type", "container"),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.test", "parameters.%", "1"),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.test", "parameters.param1", "val1"),
+					resource.TestCheckResourceAttr("aws_batch_job_definition.test", "retry_strategy.0.attempts", "1"),
+					resource.TestCheckResourceAttrSet("aws_batch_job_definition.test", "arn"),
+					resource.TestCheckResourceAttrSet("aws_batch_job_definition.test", "revision"),
+				),
+			},
+			{
+				Config: testAccAWSBatchJobDefinitionConfig(name, 256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBatchJobDefinitionExists("aws_batch_job_definition.test", &after),
+					testAccCheckAWSBatchJobDefinitionRecreated(&before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenBatchJobContainerProperties(t *testing.T) {
+	config := `{"command": ["ls"], "image": "busybox", "memory": 128, "vcpus": 1}`
+
+	props, err := expandBatchJobContainerProperties(config)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// AWS returns empty lists for the properties that were not set.
+	props.Environment = []*batch.KeyValuePair{}
+	props.MountPoints = []*batch.MountPoint{}
+	props.Ulimits = []*batch.Ulimit{}
+	props.Volumes = []*batch.Volume{}
+
+	flattened, err := flattenBatchJobContainerProperties(props)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !jsonBytesEqual([]byte(config), []byte(flattened)) {
+		t.Fatalf("Expected %s to be equivalent to %s", flattened, config)
+	}
+}
+
+func testAccCheckAWSBatchJobDefinitionExists(n string, jd *batch.JobDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Batch Job Definition ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).batchconn
+
+		resp, err := conn.DescribeJobDefinitions(&batch.DescribeJobDefinitionsInput{
+			JobDefinitions: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.JobDefinitions) == 0 {
+			return fmt.Errorf("Batch Job Definition %s not found", rs.Primary.ID)
+		}
+
+		*jd = *resp.JobDefinitions[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSBatchJobDefinitionRecreated(before, after *batch.JobDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.Int64Value(after.Revision) <= aws.Int64Value(before.Revision) {
+			return fmt.Errorf("Expected a new revision of Batch Job Definition %s, got %d after %d",
+				aws.StringValue(after.JobDefinitionName), aws.Int64Value(after.Revision), aws.Int64Value(before.Revision))
+		}
+		return nil
+	}
+}
+
+func testAccCheckAWSBatchJobDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).batchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_batch_job_definition" {
+			continue
+		}
+
+		resp, err := conn.DescribeJobDefinitions(&batch.DescribeJobDefinitionsInput{
+			JobDefinitions: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, jd := range resp.JobDefinitions {
+			if aws.StringValue(jd.Status) != "INACTIVE" {
+				return fmt.Errorf("Batch Job Definition %s is still active", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSBatchJobDefinitionConfig(name string, memory int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = "%s"
+  type = "container"
+
+  parameters = {
+    param1 = "val1"
+  }
+
+  retry_strategy {
+    attempts = 1
+  }
+
+  container_properties = <<CONTAINER_PROPERTIES
+{
+  "command": ["ls", "-la"],
+  "image": "busybox",
+  "memory": %d,
+  "vcpus": 1,
+  "environment": [
+    {"name": "VARNAME", "value": "VARVAL"}
+  ]
+}
+CONTAINER_PROPERTIES
+}
+`, name, memory)
+}
@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsBatchJobQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBatchJobQueueCreate,
+		Read:   resourceAwsBatchJobQueueRead,
+		Update: resourceAwsBatchJobQueueUpdate,
+		Delete: resourceAwsBatchJobQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchName,
+			},
+			"compute_environments": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{batch.JQStateEnabled, batch.JQStateDisabled}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	input := &batch.CreateJobQueueInput{
+		ComputeEnvironmentOrder: expandBatchComputeEnvironmentOrder(d.Get("compute_environments").([]interface{})),
+		JobQueueName:            aws.String(d.Get("name").(string)),
+		Priority:                aws.Int64(int64(d.Get("priority").(int))),
+		State:                   aws.String(d.Get("state").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Batch Job Queue: %s", input)
+	resp, err := conn.CreateJobQueue(input)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Batch Job Queue: {{err}}", err)
+	}
+
+	d.SetId(*resp.JobQueueArn)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JQStatusCreating, batch.JQStatusUpdating},
+		Target:     []string{batch.JQStatusValid},
+		Refresh:    batchJobQueueStatusRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf("Error waiting for Batch Job Queue to become valid: {{err}}", err)
+	}
+
+	return resourceAwsBatchJobQueueRead(d, meta)
+}
+
+func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	jq, err := getBatchJobQueue(conn, d.Id())
+	if err != nil {
+		return errwrap.Wrapf("Error reading Batch Job Queue: {{err}}", err)
+	}
+	if jq == nil || aws.StringValue(jq.Status) == batch.JQStatusDeleted {
+		log.Printf("[WARN] Batch Job Queue %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", jq.JobQueueName)
+	d.Set("compute_environments", flattenBatchComputeEnvironmentOrder(jq.ComputeEnvironmentOrder))
+	d.Set("priority", jq.Priority)
+	d.Set("state", jq.State)
+	d.Set("arn", jq.JobQueueArn)
+
+	return nil
+}
+
+func resourceAwsBatchJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	input := &batch.UpdateJobQueueInput{
+		ComputeEnvironmentOrder: expandBatchComputeEnvironmentOrder(d.Get("compute_environments").([]interface{})),
+		JobQueue:                aws.String(d.Id()),
+		Priority:                aws.Int64(int64(d.Get("priority").(int))),
+		State:                   aws.String(d.Get("state").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Batch Job Queue: %s", input)
+	if _, err := conn.UpdateJobQueue(input); err != nil {
+		return errwrap.Wrapf("Error updating Batch Job Queue: {{err}}", err)
+	}
+
+	if err := waitForBatchJobQueueUpdate(conn, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsBatchJobQueueRead(d, meta)
+}
+
+func resourceAwsBatchJobQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	// A job queue has to be disabled before it can be deleted.
+	if d.Get("state").(string) != batch.JQStateDisabled {
+		log.Printf("[DEBUG] Disabling Batch Job Queue %s", d.Id())
+		_, err := conn.UpdateJobQueue(&batch.UpdateJobQueueInput{
+			JobQueue: aws.String(d.Id()),
+			State:    aws.String(batch.JQStateDisabled),
+		})
+		if err != nil {
+			return errwrap.Wrapf("Error disabling Batch Job Queue: {{err}}", err)
+		}
+
+		if err := waitForBatchJobQueueUpdate(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Batch Job Queue %s", d.Id())
+	_, err := conn.DeleteJobQueue(&batch.DeleteJobQueueInput{
+		JobQueue: aws.String(d.Id()),
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error deleting Batch Job Queue: {{err}}", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JQStatusDeleting},
+		Target:     []string{batch.JQStatusDeleted},
+		Refresh:    batchJobQueueStatusRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf("Error waiting for Batch Job Queue to be deleted: {{err}}", err)
+	}
+
+	return nil
+}
+
+func waitForBatchJobQueueUpdate(conn *batch.Batch, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JQStatusUpdating},
+		Target:     []string{batch.JQStatusValid},
+		Refresh:    batchJobQueueStatusRefreshFunc(conn, id),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf("Error waiting for Batch Job Queue to become valid: {{err}}", err)
+	}
+	return nil
+}
+
+// getBatchJobQueue returns the job queue with the given name or ARN, or nil if
+// it doesn't exist.
+func getBatchJobQueue(conn *batch.Batch, id string) (*batch.JobQueueDetail, error) {
+	resp, err := conn.DescribeJobQueues(&batch.DescribeJobQueuesInput{
+		JobQueues: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(resp.JobQueues) {
+	case 0:
+		return nil, nil
+	case 1:
+		return resp.JobQueues[0], nil
+	default:
+		return nil, fmt.Errorf("Expected one Batch Job Queue named %s, found %d", id, len(resp.JobQueues))
+	}
+}
+
+func batchJobQueueStatusRefreshFunc(conn *batch.Batch, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		jq, err := getBatchJobQueue(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if jq == nil {
+			return 42, batch.JQStatusDeleted, nil
+		}
+
+		if aws.StringValue(jq.Status) == batch.JQStatusInvalid {
+			return jq, batch.JQStatusInvalid, fmt.Errorf("Batch Job Queue %s is invalid: %s", id, aws.StringValue(jq.StatusReason))
+		}
+
+		return jq, aws.StringValue(jq.Status), nil
+	}
+}
+
+// The position of each compute environment in the list sets its order.
+func expandBatchComputeEnvironmentOrder(l []interface{}) []*batch.ComputeEnvironmentOrder {
+	order := make([]*batch.ComputeEnvironmentOrder, 0, len(l))
+	for i, v := range l {
+		order = append(order, &batch.ComputeEnvironmentOrder{
+			ComputeEnvironment: aws.String(v.(string)),
+			Order:              aws.Int64(int64(i)),
+		})
+	}
+	return order
+}
+
+func flattenBatchComputeEnvironmentOrder(order []*batch.ComputeEnvironmentOrder) []string {
+	l := make([]string, len(order))
+	sorted := make([]*batch.ComputeEnvironmentOrder, len(order))
+	copy(sorted, order)
+	sort.Slice(sorted, func(i, j int) bool {
+		return aws.Int64Value(sorted[i].Order) < aws.Int64Value(sorted[j].Order)
+	})
+	for i, o := range sorted {
+		l[i] = aws.StringValue(o.ComputeEnvironment)
+	}
+	return l
+}
@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSBatchJobQueue_basic(t *testing.T) {
+	var jq batch.JobQueueDetail
+	name := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSBatchJobQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchJobQueueConfig(name, 1, batch.JQStateEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBatchJobQueueExists("aws_batch_job_queue.test", &jq),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.test", "name", name),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.test", "priority", "1"),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.test", "state", "ENABLED"),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.test", "compute_environments.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_batch_job_queue.test", "compute_environments.0", "aws_batch_compute_environment.ec2", "arn"),
+					resource.TestCheckResourceAttrSet("aws_batch_job_queue.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSBatchJobQueueConfig(name, 2, batch.JQStateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSBatchJobQueueExists("aws_batch_job_queue.test", &jq),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.test", "priority", "2"),
+					resource.TestCheckResourceAttr("aws_batch_job_queue.test", "state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSBatchJobQueueExists(n string, jq *batch.JobQueueDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Batch Job Queue ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).batchconn
+
+		queue, err := getBatchJobQueue(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if queue == nil {
+			return fmt.Errorf("Batch Job Queue %s not found", rs.Primary.ID)
+		}
+
+		*jq = *queue
+
+		return nil
+	}
+}
+
+func testAccCheckAWSBatchJobQueueDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).batchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_batch_job_queue" {
+			continue
+		}
+
+		queue, err := getBatchJobQueue(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if queue != nil && aws.StringValue(queue.Status) != batch.JQStatusDeleted {
+			return fmt.Errorf("Batch Job Queue %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSBatchJobQueueConfig(name string, priority int, state string) string {
+	return testAccAWSBatchComputeEnvironmentConfigEC2 + fmt.Sprintf(`
+resource "aws_batch_job_queue" "test" {
+  name                 = "%s"
+  priority             = %d
+  state                = "%s"
+  compute_environments = ["${aws_batch_compute_environment.ec2.arn}"]
+}
+`, name, priority, state)
+}
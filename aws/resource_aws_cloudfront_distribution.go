@@ -23,6 +23,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 			State: resourceAwsCloudFrontDistributionImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(70 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -113,8 +117,9 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 										Required: true,
 									},
 									"lambda_arn": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArnOf("lambda", "function"),
 									},
 								},
 							},
@@ -261,8 +266,9 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 										Required: true,
 									},
 									"lambda_arn": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArnOf("lambda", "function"),
 									},
 								},
 							},
@@ -470,6 +476,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Type:          schema.TypeString,
 							Optional:      true,
 							ConflictsWith: []string{"viewer_certificate.cloudfront_default_certificate", "viewer_certificate.iam_certificate_id"},
+							ValidateFunc:  validateArnOf("acm", "certificate"),
 						},
 						"cloudfront_default_certificate": {
 							Type:          schema.TypeBool,
@@ -655,7 +662,7 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 	}
 
 	// Distribution needs to be in deployed state again before it can be deleted.
-	err = resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta)
+	err = resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -679,12 +686,12 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
-func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interface{}) error {
+func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interface{}, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"InProgress"},
 		Target:     []string{"Deployed"},
 		Refresh:    resourceAwsCloudFrontWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    70 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 15 * time.Second,
 		Delay:      10 * time.Minute,
 	}
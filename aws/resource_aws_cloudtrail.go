@@ -41,12 +41,14 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Optional: true,
 			},
 			"cloud_watch_logs_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"cloud_watch_logs_group_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnOf("logs", "log-group"),
 			},
 			"include_global_service_events": {
 				Type:     schema.TypeBool,
@@ -70,7 +72,7 @@ func resourceAwsCloudTrail() *schema.Resource {
 			"kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 			"home_region": {
 				Type:     schema.TypeString,
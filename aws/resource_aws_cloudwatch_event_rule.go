@@ -53,7 +53,7 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 			"role_arn": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateMaxLength(1600),
+				ValidateFunc: validateIamRoleArn,
 			},
 			"is_enabled": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -34,13 +34,15 @@ func resourceAwsCloudWatchLogDestination() *schema.Resource {
 			},
 
 			"role_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"target_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArnOf("kinesis", "stream"),
 			},
 
 			"arn": &schema.Schema{
@@ -174,10 +174,7 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 
 	restricted := meta.(*AWSClient).IsChinaCloud() || meta.(*AWSClient).IsGovCloud()
 
-	if !restricted && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); !restricted && ok {
 		create, remove := diffCloudWatchTags(o, n)
 
 		if len(remove) > 0 {
@@ -29,9 +29,10 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				ForceNew: true,
 			},
 			"destination_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOfServices("kinesis", "lambda", "logs"),
 			},
 			"filter_pattern": {
 				Type:     schema.TypeString,
@@ -44,9 +45,10 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				ForceNew: true,
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 		},
 	}
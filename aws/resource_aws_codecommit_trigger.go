@@ -39,9 +39,10 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 						},
 
 						"destination_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArnOfServices("lambda", "sns"),
 						},
 
 						"custom_data": &schema.Schema{
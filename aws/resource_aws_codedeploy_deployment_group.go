@@ -53,8 +53,9 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 			},
 
 			"service_role_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"alarm_configuration": &schema.Schema{
@@ -198,8 +199,9 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 						},
 
 						"trigger_target_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 					},
 				},
@@ -31,8 +31,9 @@ func resourceAwsCodePipeline() *schema.Resource {
 			},
 
 			"role_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"artifact_store": {
@@ -126,8 +127,9 @@ func resourceAwsCodePipeline() *schema.Resource {
 										Required: true,
 									},
 									"role_arn": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateIamRoleArn,
 									},
 									"run_order": {
 										Type:     schema.TypeInt,
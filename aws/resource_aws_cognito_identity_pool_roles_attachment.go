@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityPoolRolesAttachmentCreate,
+		Read:   resourceAwsCognitoIdentityPoolRolesAttachmentRead,
+		Update: resourceAwsCognitoIdentityPoolRolesAttachmentUpdate,
+		Delete: resourceAwsCognitoIdentityPoolRolesAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identity_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoIdentityPoolId,
+			},
+
+			"role_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_provider": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCognitoRoleMappingIdentityProvider,
+						},
+
+						"ambiguous_role_resolution": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cognitoidentity.AmbiguousRoleResolutionTypeAuthenticatedRole,
+								cognitoidentity.AmbiguousRoleResolutionTypeDeny,
+							}, false),
+						},
+
+						"mapping_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateCognitoRoleMappingRuleClaim,
+									},
+									"match_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											cognitoidentity.MappingRuleMatchTypeEquals,
+											cognitoidentity.MappingRuleMatchTypeContains,
+											cognitoidentity.MappingRuleMatchTypeStartsWith,
+											cognitoidentity.MappingRuleMatchTypeNotEqual,
+										}, false),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIamRoleArn,
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cognitoidentity.RoleMappingTypeToken,
+								cognitoidentity.RoleMappingTypeRules,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"roles": {
+				Type:         schema.TypeMap,
+				Required:     true,
+				ValidateFunc: validateCognitoRoles,
+			},
+		},
+	}
+}
+
+// resourceAwsCognitoIdentityPoolRolesAttachmentCustomizeDiff checks the role
+// mappings at plan time.
+func resourceAwsCognitoIdentityPoolRolesAttachmentCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		resourceAwsCognitoIdentityPoolRolesAttachmentValidateRoleMappings,
+	)
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	params := &cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Get("identity_pool_id").(string)),
+		Roles:          stringMapToPointers(d.Get("roles").(map[string]interface{})),
+		RoleMappings:   expandCognitoIdentityPoolRoleMappings(d.Get("role_mapping").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating Cognito Identity Pool Roles Attachment: %s", params)
+
+	_, err := conn.SetIdentityPoolRoles(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Cognito Identity Pool Roles Attachment: {{err}}", err)
+	}
+
+	d.SetId(d.Get("identity_pool_id").(string))
+
+	return resourceAwsCognitoIdentityPoolRolesAttachmentRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+	log.Printf("[DEBUG] Reading Cognito Identity Pool Roles Attachment: %s", d.Id())
+
+	ip, err := conn.GetIdentityPoolRoles(&cognitoidentity.GetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			log.Printf("[WARN] Cognito Identity Pool %s not found, removing roles attachment from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("identity_pool_id", ip.IdentityPoolId)
+
+	if err := d.Set("roles", pointersMapToStringList(ip.Roles)); err != nil {
+		return fmt.Errorf("Error setting roles: %s", err)
+	}
+
+	if err := d.Set("role_mapping", flattenCognitoIdentityPoolRoleMappings(ip.RoleMappings)); err != nil {
+		return fmt.Errorf("Error setting role_mapping: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+
+	// SetIdentityPoolRoles replaces both the roles and the role mappings, so
+	// all of them are sent on every update.
+	params := &cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+		Roles:          stringMapToPointers(d.Get("roles").(map[string]interface{})),
+		RoleMappings:   expandCognitoIdentityPoolRoleMappings(d.Get("role_mapping").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Updating Cognito Identity Pool Roles Attachment: %s", params)
+
+	_, err := conn.SetIdentityPoolRoles(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Cognito Identity Pool Roles Attachment: {{err}}", err)
+	}
+
+	return resourceAwsCognitoIdentityPoolRolesAttachmentRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityPoolRolesAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoconn
+	log.Printf("[DEBUG] Deleting Cognito Identity Pool Roles Attachment: %s", d.Id())
+
+	// The roles can't be deleted, only replaced by an empty set of roles.
+	_, err := conn.SetIdentityPoolRoles(&cognitoidentity.SetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(d.Id()),
+		Roles:          map[string]*string{},
+		RoleMappings:   map[string]*cognitoidentity.RoleMapping{},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Cognito Identity Pool Roles Attachment: {{err}}", err)
+	}
+
+	return nil
+}
+
+// resourceAwsCognitoIdentityPoolRolesAttachmentValidateRoleMappings checks
+// that the mapping rules are given only, and always, for rules-based
+// mappings, and that every identity provider is mapped only once.
+func resourceAwsCognitoIdentityPoolRolesAttachmentValidateRoleMappings(d *resourceDiff) error {
+	if !d.NewValueKnown("role_mapping") {
+		return nil
+	}
+
+	var errs *multierror.Error
+	providers := make(map[string]bool)
+	for _, raw := range d.Get("role_mapping").(*schema.Set).List() {
+		rm := raw.(map[string]interface{})
+		provider := rm["identity_provider"].(string)
+		rules := rm["mapping_rule"].([]interface{})
+
+		switch rm["type"].(string) {
+		case cognitoidentity.RoleMappingTypeRules:
+			if len(rules) == 0 {
+				errs = multierror.Append(errs, fmt.Errorf(
+					"role_mapping %q: mapping_rule is required for type %s", provider, cognitoidentity.RoleMappingTypeRules))
+			}
+		case cognitoidentity.RoleMappingTypeToken:
+			if len(rules) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf(
+					"role_mapping %q: mapping_rule can't be set for type %s", provider, cognitoidentity.RoleMappingTypeToken))
+			}
+		}
+
+		if providers[provider] {
+			errs = multierror.Append(errs, fmt.Errorf("role_mapping %q: identity_provider is mapped more than once", provider))
+		}
+		providers[provider] = true
+	}
+
+	return errs.ErrorOrNil()
+}
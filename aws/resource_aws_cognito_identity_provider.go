@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoIdentityProviderCreate,
+		Read:   resourceAwsCognitoIdentityProviderRead,
+		Update: resourceAwsCognitoIdentityProviderUpdate,
+		Delete: resourceAwsCognitoIdentityProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoIdentityProviderImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attribute_mapping": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"idp_identifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 40),
+				},
+			},
+
+			"provider_details": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoIdentityProviderName,
+			},
+
+			"provider_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					cognitoidentityprovider.IdentityProviderTypeTypeSaml,
+					cognitoidentityprovider.IdentityProviderTypeTypeFacebook,
+					cognitoidentityprovider.IdentityProviderTypeTypeGoogle,
+					cognitoidentityprovider.IdentityProviderTypeTypeLoginWithAmazon,
+				}, false),
+			},
+
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoIdentityProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	providerName := d.Get("provider_name").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+
+	params := &cognitoidentityprovider.CreateIdentityProviderInput{
+		ProviderName:    aws.String(providerName),
+		ProviderType:    aws.String(d.Get("provider_type").(string)),
+		ProviderDetails: stringMapToPointers(d.Get("provider_details").(map[string]interface{})),
+		UserPoolId:      aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("attribute_mapping"); ok {
+		params.AttributeMapping = stringMapToPointers(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("idp_identifiers"); ok {
+		params.IdpIdentifiers = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito Identity Provider: %s", params)
+
+	_, err := conn.CreateIdentityProvider(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Cognito Identity Provider: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolID, providerName))
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Reading Cognito Identity Provider: %s", d.Id())
+
+	resp, err := conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			log.Printf("[WARN] Cognito Identity Provider %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	ip := resp.IdentityProvider
+
+	d.Set("provider_name", ip.ProviderName)
+	d.Set("provider_type", ip.ProviderType)
+	d.Set("user_pool_id", ip.UserPoolId)
+
+	if err := d.Set("idp_identifiers", flattenStringList(ip.IdpIdentifiers)); err != nil {
+		return fmt.Errorf("Error setting idp_identifiers: %s", err)
+	}
+
+	// Cognito adds endpoints of the provider to its details, and maps the
+	// user name of every provider. They're kept out of the state unless
+	// they're configured, so they don't show up as a diff.
+	details := flattenCognitoIdentityProviderMap(d.Get("provider_details").(map[string]interface{}), ip.ProviderDetails, []string{
+		"attributes_url",
+		"attributes_url_add_attributes",
+		"authorize_url",
+		"oidc_issuer",
+		"token_request_method",
+		"token_url",
+	})
+	if err := d.Set("provider_details", details); err != nil {
+		return fmt.Errorf("Error setting provider_details: %s", err)
+	}
+
+	mapping := flattenCognitoIdentityProviderMap(d.Get("attribute_mapping").(map[string]interface{}), ip.AttributeMapping, []string{
+		"username",
+	})
+	if err := d.Set("attribute_mapping", mapping); err != nil {
+		return fmt.Errorf("Error setting attribute_mapping: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.UpdateIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if d.HasChange("attribute_mapping") {
+		params.AttributeMapping = stringMapToPointers(d.Get("attribute_mapping").(map[string]interface{}))
+	}
+	if d.HasChange("idp_identifiers") {
+		params.IdpIdentifiers = expandStringList(d.Get("idp_identifiers").([]interface{}))
+	}
+	if d.HasChange("provider_details") {
+		params.ProviderDetails = stringMapToPointers(d.Get("provider_details").(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito Identity Provider: %s", params)
+
+	_, err := conn.UpdateIdentityProvider(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Cognito Identity Provider: {{err}}", err)
+	}
+
+	return resourceAwsCognitoIdentityProviderRead(d, meta)
+}
+
+func resourceAwsCognitoIdentityProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Deleting Cognito Identity Provider: %s", d.Id())
+
+	_, err := conn.DeleteIdentityProvider(&cognitoidentityprovider.DeleteIdentityProviderInput{
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		UserPoolId:   aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Cognito Identity Provider: {{err}}", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoIdentityProviderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'user-pool-id/provider-name'", d.Id())
+	}
+
+	d.Set("user_pool_id", parts[0])
+	d.Set("provider_name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// flattenCognitoIdentityProviderMap returns the values read from Cognito,
+// without the keys added by Cognito that aren't configured. All of the keys
+// are returned when nothing is configured, e.g. when importing.
+func flattenCognitoIdentityProviderMap(configured map[string]interface{}, values map[string]*string, added []string) map[string]interface{} {
+	result := pointersMapToStringList(values)
+	if len(configured) == 0 {
+		return result
+	}
+	for _, k := range added {
+		if _, ok := configured[k]; !ok {
+			delete(result, k)
+		}
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoIdentityProvider_basic(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(name, "email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists("aws_cognito_identity_provider.main"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.main", "provider_name", "Google"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.main", "provider_type", "Google"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.main", "provider_details.%", "3"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.main", "provider_details.authorize_scopes", "email"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.main", "attribute_mapping.%", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.main", "attribute_mapping.email", "email"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_basic(name, "email profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists("aws_cognito_identity_provider.main"),
+					resource.TestCheckResourceAttr("aws_cognito_identity_provider.main", "provider_details.authorize_scopes", "email profile"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenCognitoIdentityProviderMap(t *testing.T) {
+	values := map[string]*string{
+		"client_id":      aws.String("id"),
+		"authorize_url":  aws.String("https://accounts.google.com/o/oauth2/v2/auth"),
+		"oidc_issuer":    aws.String("https://accounts.google.com"),
+		"token_url":      aws.String("https://www.googleapis.com/oauth2/v4/token"),
+		"token_endpoint": aws.String("https://www.googleapis.com/oauth2/v4/token"),
+	}
+	added := []string{"authorize_url", "oidc_issuer", "token_url"}
+
+	cases := []struct {
+		Configured map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Configured: map[string]interface{}{
+				"client_id":      "id",
+				"token_endpoint": "https://www.googleapis.com/oauth2/v4/token",
+			},
+			Expected: map[string]interface{}{
+				"client_id":      "id",
+				"token_endpoint": "https://www.googleapis.com/oauth2/v4/token",
+			},
+		},
+		{
+			Configured: map[string]interface{}{
+				"client_id":   "id",
+				"oidc_issuer": "https://accounts.google.com",
+			},
+			Expected: map[string]interface{}{
+				"client_id":      "id",
+				"oidc_issuer":    "https://accounts.google.com",
+				"token_endpoint": "https://www.googleapis.com/oauth2/v4/token",
+			},
+		},
+		{
+			// Nothing is configured when importing
+			Configured: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"client_id":      "id",
+				"authorize_url":  "https://accounts.google.com/o/oauth2/v2/auth",
+				"oidc_issuer":    "https://accounts.google.com",
+				"token_url":      "https://www.googleapis.com/oauth2/v4/token",
+				"token_endpoint": "https://www.googleapis.com/oauth2/v4/token",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		result := flattenCognitoIdentityProviderMap(tc.Configured, values, added)
+		if !reflect.DeepEqual(result, tc.Expected) {
+			t.Errorf("%d: expected %#v, got %#v", i, tc.Expected, result)
+		}
+	}
+}
+
+func testAccCheckAWSCognitoIdentityProviderExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito Identity Provider ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		_, err := conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(rs.Primary.Attributes["provider_name"]),
+			UserPoolId:   aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoIdentityProviderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_identity_provider" {
+			continue
+		}
+
+		_, err := conn.DescribeIdentityProvider(&cognitoidentityprovider.DescribeIdentityProviderInput{
+			ProviderName: aws.String(rs.Primary.Attributes["provider_name"]),
+			UserPoolId:   aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			if wserr, ok := err.(awserr.Error); ok && wserr.Code() == "ResourceNotFoundException" {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cognito Identity Provider %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoIdentityProviderConfig_basic(name, scopes string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name                     = "tf-acc-test-pool-%s"
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "main" {
+  user_pool_id  = "${aws_cognito_user_pool.main.id}"
+  provider_name = "Google"
+  provider_type = "Google"
+
+  provider_details {
+    authorize_scopes = "%s"
+    client_id        = "test-url.apps.googleusercontent.com"
+    client_secret    = "client_secret"
+  }
+
+  attribute_mapping {
+    email = "email"
+  }
+}
+`, name, scopes)
+}
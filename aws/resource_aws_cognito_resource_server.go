@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoResourceServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoResourceServerCreate,
+		Read:   resourceAwsCognitoResourceServerRead,
+		Update: resourceAwsCognitoResourceServerUpdate,
+		Delete: resourceAwsCognitoResourceServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoResourceServerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"scope": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"scope_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCognitoResourceServerScopeName,
+						},
+					},
+				},
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scope_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoResourceServerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	identifier := d.Get("identifier").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+
+	params := &cognitoidentityprovider.CreateResourceServerInput{
+		Identifier: aws.String(identifier),
+		Name:       aws.String(d.Get("name").(string)),
+		Scopes:     expandCognitoResourceServerScopes(d.Get("scope").(*schema.Set).List()),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Creating Cognito Resource Server: %s", params)
+
+	_, err := conn.CreateResourceServer(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Cognito Resource Server: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userPoolID, identifier))
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Reading Cognito Resource Server: %s", d.Id())
+
+	resp, err := conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			log.Printf("[WARN] Cognito Resource Server %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	server := resp.ResourceServer
+
+	d.Set("identifier", server.Identifier)
+	d.Set("name", server.Name)
+	d.Set("user_pool_id", server.UserPoolId)
+
+	if err := d.Set("scope", flattenCognitoResourceServerScopes(server.Scopes)); err != nil {
+		return fmt.Errorf("Error setting scope: %s", err)
+	}
+
+	// Clients request the scopes as "<identifier>/<scope_name>".
+	var scopeIdentifiers []string
+	for _, scope := range server.Scopes {
+		scopeIdentifiers = append(scopeIdentifiers, fmt.Sprintf("%s/%s", *server.Identifier, *scope.ScopeName))
+	}
+	if err := d.Set("scope_identifiers", scopeIdentifiers); err != nil {
+		return fmt.Errorf("Error setting scope_identifiers: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoResourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.UpdateResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		Name:       aws.String(d.Get("name").(string)),
+		Scopes:     expandCognitoResourceServerScopes(d.Get("scope").(*schema.Set).List()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Cognito Resource Server: %s", params)
+
+	_, err := conn.UpdateResourceServer(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Cognito Resource Server: {{err}}", err)
+	}
+
+	return resourceAwsCognitoResourceServerRead(d, meta)
+}
+
+func resourceAwsCognitoResourceServerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Deleting Cognito Resource Server: %s", d.Id())
+
+	_, err := conn.DeleteResourceServer(&cognitoidentityprovider.DeleteResourceServerInput{
+		Identifier: aws.String(d.Get("identifier").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Cognito Resource Server: {{err}}", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoResourceServerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The identifier is usually a URL, so the ID is split at its first slash.
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'user-pool-id/identifier'", d.Id())
+	}
+
+	d.Set("user_pool_id", parts[0])
+	d.Set("identifier", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
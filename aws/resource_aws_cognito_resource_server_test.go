@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoResourceServer_basic(t *testing.T) {
+	identifier := fmt.Sprintf("https://example.com/%s", acctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoResourceServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoResourceServerConfig_basic(identifier, "first", poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists("aws_cognito_resource_server.main"),
+					resource.TestCheckResourceAttr("aws_cognito_resource_server.main", "identifier", identifier),
+					resource.TestCheckResourceAttr("aws_cognito_resource_server.main", "name", "first"),
+					resource.TestCheckResourceAttr("aws_cognito_resource_server.main", "scope.#", "0"),
+					resource.TestCheckResourceAttr("aws_cognito_resource_server.main", "scope_identifiers.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoResourceServerConfig_scopes(identifier, "second", poolName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoResourceServerExists("aws_cognito_resource_server.main"),
+					resource.TestCheckResourceAttr("aws_cognito_resource_server.main", "name", "second"),
+					resource.TestCheckResourceAttr("aws_cognito_resource_server.main", "scope.#", "2"),
+					resource.TestCheckResourceAttr("aws_cognito_resource_server.main", "scope_identifiers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoResourceServerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito Resource Server ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		_, err := conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+			Identifier: aws.String(rs.Primary.Attributes["identifier"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoResourceServerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_resource_server" {
+			continue
+		}
+
+		_, err := conn.DescribeResourceServer(&cognitoidentityprovider.DescribeResourceServerInput{
+			Identifier: aws.String(rs.Primary.Attributes["identifier"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			if wserr, ok := err.(awserr.Error); ok && wserr.Code() == "ResourceNotFoundException" {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cognito Resource Server %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoResourceServerConfig_basic(identifier, name, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_resource_server" "main" {
+  identifier   = "%s"
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+`, identifier, name, poolName)
+}
+
+func testAccAWSCognitoResourceServerConfig_scopes(identifier, name, poolName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_resource_server" "main" {
+  identifier   = "%s"
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+
+  scope {
+    scope_name        = "scope_1_name"
+    scope_description = "scope_1_description"
+  }
+
+  scope {
+    scope_name        = "scope_2_name"
+    scope_description = "scope_2_description"
+  }
+}
+
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+`, identifier, name, poolName)
+}
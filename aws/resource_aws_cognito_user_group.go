@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoUserGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserGroupCreate,
+		Read:   resourceAwsCognitoUserGroupRead,
+		Update: resourceAwsCognitoUserGroupUpdate,
+		Delete: resourceAwsCognitoUserGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserGroupName,
+			},
+			"precedence": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.CreateGroupInput{
+		GroupName:  aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("precedence"); ok {
+		params.Precedence = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("role_arn"); ok {
+		params.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito User Group: %s", params)
+
+	resp, err := conn.CreateGroup(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Cognito User Group: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *resp.Group.UserPoolId, *resp.Group.GroupName))
+
+	return resourceAwsCognitoUserGroupRead(d, meta)
+}
+
+func resourceAwsCognitoUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Reading Cognito User Group: %s", d.Id())
+
+	resp, err := conn.GetGroup(&cognitoidentityprovider.GetGroupInput{
+		GroupName:  aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			log.Printf("[WARN] Cognito User Group %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("description", resp.Group.Description)
+	d.Set("name", resp.Group.GroupName)
+	d.Set("precedence", resp.Group.Precedence)
+	d.Set("role_arn", resp.Group.RoleArn)
+	d.Set("user_pool_id", resp.Group.UserPoolId)
+
+	return nil
+}
+
+func resourceAwsCognitoUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// Settings that aren't given are removed from the group, so all of them
+	// are sent on every update.
+	params := &cognitoidentityprovider.UpdateGroupInput{
+		GroupName:  aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("precedence"); ok {
+		params.Precedence = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("role_arn"); ok {
+		params.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito User Group: %s", params)
+
+	_, err := conn.UpdateGroup(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Cognito User Group: {{err}}", err)
+	}
+
+	return resourceAwsCognitoUserGroupRead(d, meta)
+}
+
+func resourceAwsCognitoUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Deleting Cognito User Group: %s", d.Id())
+
+	_, err := conn.DeleteGroup(&cognitoidentityprovider.DeleteGroupInput{
+		GroupName:  aws.String(d.Get("name").(string)),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Cognito User Group: {{err}}", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'user-pool-id/group-name'", d.Id())
+	}
+
+	d.Set("user_pool_id", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserGroup_basic(t *testing.T) {
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserGroupConfig_basic(poolName, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserGroupExists("aws_cognito_user_group.main"),
+					resource.TestCheckResourceAttr("aws_cognito_user_group.main", "name", groupName),
+					resource.TestCheckResourceAttr("aws_cognito_user_group.main", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserGroup_complex(t *testing.T) {
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserGroupConfig_complex(poolName, groupName, "This is the user group description", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserGroupExists("aws_cognito_user_group.main"),
+					resource.TestCheckResourceAttr("aws_cognito_user_group.main", "description", "This is the user group description"),
+					resource.TestCheckResourceAttr("aws_cognito_user_group.main", "precedence", "1"),
+					resource.TestCheckResourceAttrPair("aws_cognito_user_group.main", "role_arn", "aws_iam_role.group_role", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserGroupConfig_complex(poolName, groupName, "This is the updated user group description", 42),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserGroupExists("aws_cognito_user_group.main"),
+					resource.TestCheckResourceAttr("aws_cognito_user_group.main", "description", "This is the updated user group description"),
+					resource.TestCheckResourceAttr("aws_cognito_user_group.main", "precedence", "42"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito User Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		_, err := conn.GetGroup(&cognitoidentityprovider.GetGroupInput{
+			GroupName:  aws.String(rs.Primary.Attributes["name"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoUserGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_group" {
+			continue
+		}
+
+		_, err := conn.GetGroup(&cognitoidentityprovider.GetGroupInput{
+			GroupName:  aws.String(rs.Primary.Attributes["name"]),
+			UserPoolId: aws.String(rs.Primary.Attributes["user_pool_id"]),
+		})
+
+		if err != nil {
+			if wserr, ok := err.(awserr.Error); ok && wserr.Code() == "ResourceNotFoundException" {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cognito User Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserGroupConfig_basic(poolName, groupName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%s"
+}
+
+resource "aws_cognito_user_group" "main" {
+  name         = "%s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+}
+`, poolName, groupName)
+}
+
+func testAccAWSCognitoUserGroupConfig_complex(poolName, groupName, groupDescription string, precedence int) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "main" {
+  name = "%[1]s"
+}
+
+resource "aws_iam_role" "group_role" {
+  name = "%[2]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Federated": "cognito-identity.amazonaws.com"
+      },
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {
+        "StringEquals": {
+          "cognito-identity.amazonaws.com:aud": "us-east-1:12345678-dead-beef-cafe-123456790ab"
+        },
+        "ForAnyValue:StringLike": {
+          "cognito-identity.amazonaws.com:amr": "authenticated"
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cognito_user_group" "main" {
+  name         = "%[2]s"
+  user_pool_id = "${aws_cognito_user_pool.main.id}"
+  description  = "%[3]s"
+  precedence   = %[4]d
+  role_arn     = "${aws_iam_role.group_role.arn}"
+}
+`, poolName, groupName, groupDescription, precedence)
+}
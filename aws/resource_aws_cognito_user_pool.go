@@ -0,0 +1,739 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoUserPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolCreate,
+		Read:   resourceAwsCognitoUserPoolRead,
+		Update: resourceAwsCognitoUserPoolUpdate,
+		Delete: resourceAwsCognitoUserPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolName,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_create_user_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_admin_create_user_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"invite_message_template": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"email_message": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCognitoUserPoolInviteTemplateEmailMessage,
+									},
+									"email_subject": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 140),
+									},
+									"sms_message": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCognitoUserPoolInviteTemplateSmsMessage,
+									},
+								},
+							},
+						},
+						"unused_account_validity_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      7,
+							ValidateFunc: validation.IntBetween(0, 90),
+						},
+					},
+				},
+			},
+
+			"alias_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.AliasAttributeTypeEmail,
+						cognitoidentityprovider.AliasAttributeTypePhoneNumber,
+						cognitoidentityprovider.AliasAttributeTypePreferredUsername,
+					}, false),
+				},
+				ConflictsWith: []string{"username_attributes"},
+			},
+
+			"username_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.UsernameAttributeTypeEmail,
+						cognitoidentityprovider.UsernameAttributeTypePhoneNumber,
+					}, false),
+				},
+				ConflictsWith: []string{"alias_attributes"},
+			},
+
+			"auto_verified_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.VerifiedAttributeTypeEmail,
+						cognitoidentityprovider.VerifiedAttributeTypePhoneNumber,
+					}, false),
+				},
+			},
+
+			"device_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"challenge_required_on_new_device": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"device_only_remembered_on_user_prompt": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"email_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reply_to_email_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("ses", "identity"),
+						},
+					},
+				},
+			},
+
+			"email_verification_subject": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 140),
+			},
+
+			"email_verification_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateCognitoUserPoolEmailVerificationMessage,
+			},
+
+			"lambda_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create_auth_challenge": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+						"custom_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+						"define_auth_challenge": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+						"post_authentication": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+						"post_confirmation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+						"pre_authentication": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+						"pre_sign_up": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+						"verify_auth_challenge_response": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
+						},
+					},
+				},
+			},
+
+			"mfa_configuration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  cognitoidentityprovider.UserPoolMfaTypeOff,
+				ValidateFunc: validation.StringInSlice([]string{
+					cognitoidentityprovider.UserPoolMfaTypeOff,
+					cognitoidentityprovider.UserPoolMfaTypeOn,
+					cognitoidentityprovider.UserPoolMfaTypeOptional,
+				}, false),
+			},
+
+			"password_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minimum_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(6, 99),
+						},
+						"require_lowercase": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"require_numbers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"require_symbols": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"require_uppercase": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"schema": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_data_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cognitoidentityprovider.AttributeDataTypeString,
+								cognitoidentityprovider.AttributeDataTypeNumber,
+								cognitoidentityprovider.AttributeDataTypeDateTime,
+								cognitoidentityprovider.AttributeDataTypeBoolean,
+							}, false),
+						},
+						"developer_only_attribute": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"mutable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCognitoUserPoolSchemaName,
+						},
+						"number_attribute_constraints": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"max_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"string_attribute_constraints": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_length": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"max_length": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"sms_authentication_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateCognitoUserPoolSmsMessage,
+			},
+
+			"sms_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sns_caller_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
+						},
+					},
+				},
+			},
+
+			"sms_verification_message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateCognitoUserPoolSmsMessage,
+			},
+
+			"tags": tagsSchema(),
+
+			"verification_message_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_email_option": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  cognitoidentityprovider.DefaultEmailOptionTypeConfirmWithCode,
+							ValidateFunc: validation.StringInSlice([]string{
+								cognitoidentityprovider.DefaultEmailOptionTypeConfirmWithLink,
+								cognitoidentityprovider.DefaultEmailOptionTypeConfirmWithCode,
+							}, false),
+						},
+						"email_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateCognitoUserPoolEmailVerificationMessage,
+						},
+						"email_message_by_link": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateCognitoUserPoolEmailVerificationMessageByLink,
+						},
+						"email_subject": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+						"email_subject_by_link": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+						"sms_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateCognitoUserPoolSmsMessage,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsCognitoUserPoolCustomizeDiff checks the MFA settings of a pool at
+// plan time.
+func resourceAwsCognitoUserPoolCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		resourceAwsCognitoUserPoolValidateMfa,
+	)
+}
+
+func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.CreateUserPoolInput{
+		PoolName:               aws.String(d.Get("name").(string)),
+		AdminCreateUserConfig:  expandCognitoUserPoolAdminCreateUserConfig(d.Get("admin_create_user_config").([]interface{})),
+		AutoVerifiedAttributes: expandStringList(d.Get("auto_verified_attributes").(*schema.Set).List()),
+		DeviceConfiguration:    expandCognitoUserPoolDeviceConfiguration(d.Get("device_configuration").([]interface{})),
+		EmailConfiguration:     expandCognitoUserPoolEmailConfiguration(d.Get("email_configuration").([]interface{})),
+		LambdaConfig:           expandCognitoUserPoolLambdaConfig(d.Get("lambda_config").([]interface{})),
+		MfaConfiguration:       aws.String(d.Get("mfa_configuration").(string)),
+		Policies:               expandCognitoUserPoolPolicies(d.Get("password_policy").([]interface{})),
+		SmsConfiguration:       expandCognitoUserPoolSmsConfiguration(d.Get("sms_configuration").([]interface{})),
+		UserPoolTags:           tagsFromMapGeneric(d.Get("tags").(map[string]interface{})),
+		VerificationMessageTemplate: expandCognitoUserPoolVerificationMessageTemplate(
+			d.Get("verification_message_template").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("alias_attributes"); ok {
+		params.AliasAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("username_attributes"); ok {
+		params.UsernameAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("email_verification_subject"); ok {
+		params.EmailVerificationSubject = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("email_verification_message"); ok {
+		params.EmailVerificationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schema"); ok {
+		params.Schema = expandCognitoUserPoolSchema(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("sms_authentication_message"); ok {
+		params.SmsAuthenticationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_verification_message"); ok {
+		params.SmsVerificationMessage = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
+
+	var resp *cognitoidentityprovider.CreateUserPoolOutput
+	// The IAM role for sending SMS messages may not have propagated yet
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateUserPool(params)
+		if err != nil {
+			if isCognitoUserPoolSmsRoleError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error creating Cognito User Pool: {{err}}", err)
+	}
+
+	d.SetId(*resp.UserPool.Id)
+
+	return resourceAwsCognitoUserPoolRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.cognitoidpconn
+	log.Printf("[DEBUG] Reading Cognito User Pool: %s", d.Id())
+
+	resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			log.Printf("[WARN] Cognito User Pool %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	pool := resp.UserPool
+
+	d.Set("name", pool.Name)
+	d.Set("arn", cognitoUserPoolArn(client, d.Id()))
+	d.Set("endpoint", fmt.Sprintf("cognito-idp.%s.amazonaws.com/%s", client.region, d.Id()))
+	if pool.CreationDate != nil {
+		d.Set("creation_date", pool.CreationDate.Format(time.RFC3339))
+	}
+	if pool.LastModifiedDate != nil {
+		d.Set("last_modified_date", pool.LastModifiedDate.Format(time.RFC3339))
+	}
+
+	if err := d.Set("admin_create_user_config", flattenCognitoUserPoolAdminCreateUserConfig(pool.AdminCreateUserConfig)); err != nil {
+		return fmt.Errorf("Error setting admin_create_user_config: %s", err)
+	}
+	if err := d.Set("alias_attributes", flattenStringList(pool.AliasAttributes)); err != nil {
+		return fmt.Errorf("Error setting alias_attributes: %s", err)
+	}
+	if err := d.Set("username_attributes", flattenStringList(pool.UsernameAttributes)); err != nil {
+		return fmt.Errorf("Error setting username_attributes: %s", err)
+	}
+	if err := d.Set("auto_verified_attributes", flattenStringList(pool.AutoVerifiedAttributes)); err != nil {
+		return fmt.Errorf("Error setting auto_verified_attributes: %s", err)
+	}
+	if err := d.Set("device_configuration", flattenCognitoUserPoolDeviceConfiguration(pool.DeviceConfiguration)); err != nil {
+		return fmt.Errorf("Error setting device_configuration: %s", err)
+	}
+	if err := d.Set("email_configuration", flattenCognitoUserPoolEmailConfiguration(pool.EmailConfiguration)); err != nil {
+		return fmt.Errorf("Error setting email_configuration: %s", err)
+	}
+	d.Set("email_verification_subject", pool.EmailVerificationSubject)
+	d.Set("email_verification_message", pool.EmailVerificationMessage)
+	if err := d.Set("lambda_config", flattenCognitoUserPoolLambdaConfig(pool.LambdaConfig)); err != nil {
+		return fmt.Errorf("Error setting lambda_config: %s", err)
+	}
+	d.Set("mfa_configuration", pool.MfaConfiguration)
+	if err := d.Set("password_policy", flattenCognitoUserPoolPasswordPolicy(pool.Policies)); err != nil {
+		return fmt.Errorf("Error setting password_policy: %s", err)
+	}
+
+	var configured []interface{}
+	if v, ok := d.GetOk("schema"); ok {
+		configured = v.(*schema.Set).List()
+	}
+	if err := d.Set("schema", flattenCognitoUserPoolSchema(configured, pool.SchemaAttributes)); err != nil {
+		return fmt.Errorf("Error setting schema: %s", err)
+	}
+
+	d.Set("sms_authentication_message", pool.SmsAuthenticationMessage)
+	if err := d.Set("sms_configuration", flattenCognitoUserPoolSmsConfiguration(pool.SmsConfiguration)); err != nil {
+		return fmt.Errorf("Error setting sms_configuration: %s", err)
+	}
+	d.Set("sms_verification_message", pool.SmsVerificationMessage)
+	d.Set("tags", tagsToMapGeneric(pool.UserPoolTags))
+	if err := d.Set("verification_message_template", flattenCognitoUserPoolVerificationMessageTemplate(pool.VerificationMessageTemplate)); err != nil {
+		return fmt.Errorf("Error setting verification_message_template: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	conn := client.cognitoidpconn
+
+	// UpdateUserPool resets every setting that isn't given to its default,
+	// so the whole configuration is sent on every update.
+	params := &cognitoidentityprovider.UpdateUserPoolInput{
+		UserPoolId:             aws.String(d.Id()),
+		AdminCreateUserConfig:  expandCognitoUserPoolAdminCreateUserConfig(d.Get("admin_create_user_config").([]interface{})),
+		AutoVerifiedAttributes: expandStringList(d.Get("auto_verified_attributes").(*schema.Set).List()),
+		DeviceConfiguration:    expandCognitoUserPoolDeviceConfiguration(d.Get("device_configuration").([]interface{})),
+		EmailConfiguration:     expandCognitoUserPoolEmailConfiguration(d.Get("email_configuration").([]interface{})),
+		LambdaConfig:           expandCognitoUserPoolLambdaConfig(d.Get("lambda_config").([]interface{})),
+		MfaConfiguration:       aws.String(d.Get("mfa_configuration").(string)),
+		Policies:               expandCognitoUserPoolPolicies(d.Get("password_policy").([]interface{})),
+		SmsConfiguration:       expandCognitoUserPoolSmsConfiguration(d.Get("sms_configuration").([]interface{})),
+		UserPoolTags:           tagsFromMapGeneric(d.Get("tags").(map[string]interface{})),
+		VerificationMessageTemplate: expandCognitoUserPoolVerificationMessageTemplate(
+			d.Get("verification_message_template").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("email_verification_subject"); ok {
+		params.EmailVerificationSubject = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("email_verification_message"); ok {
+		params.EmailVerificationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_authentication_message"); ok {
+		params.SmsAuthenticationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_verification_message"); ok {
+		params.SmsVerificationMessage = aws.String(v.(string))
+	}
+
+	// The verification messages are set by both the top-level arguments and
+	// the template, only the changed ones are sent so they don't conflict.
+	if params.VerificationMessageTemplate != nil {
+		if !d.HasChange("email_verification_message") {
+			params.EmailVerificationMessage = nil
+		}
+		if !d.HasChange("email_verification_subject") {
+			params.EmailVerificationSubject = nil
+		}
+		if !d.HasChange("sms_verification_message") {
+			params.SmsVerificationMessage = nil
+		}
+	}
+
+	// UpdateUserPool replaces the whole tag set, keep the ignored tags that
+	// never made it into state.
+	if len(client.ignoreTagKeys) > 0 || len(client.ignoreTagKeyPrefixes) > 0 {
+		resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(d.Id()),
+		})
+		if err != nil {
+			return errwrap.Wrapf("Error reading Cognito User Pool tags: {{err}}", err)
+		}
+		params.UserPoolTags = mergeIgnoredTagsGeneric(params.UserPoolTags, resp.UserPool.UserPoolTags,
+			client.ignoreTagKeys, client.ignoreTagKeyPrefixes)
+	}
+
+	log.Printf("[DEBUG] Updating Cognito User Pool: %s", params)
+
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.UpdateUserPool(params)
+		if err != nil {
+			if isCognitoUserPoolSmsRoleError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error updating Cognito User Pool: {{err}}", err)
+	}
+
+	return resourceAwsCognitoUserPoolRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Deleting Cognito User Pool: %s", d.Id())
+
+	_, err := conn.DeleteUserPool(&cognitoidentityprovider.DeleteUserPoolInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Cognito User Pool: {{err}}", err)
+	}
+
+	return nil
+}
+
+// resourceAwsCognitoUserPoolValidateMfa checks that SMS messages can be sent
+// when multi-factor authentication is enabled.
+func resourceAwsCognitoUserPoolValidateMfa(d *resourceDiff) error {
+	if !d.NewValueKnown("mfa_configuration") {
+		return nil
+	}
+	if d.Get("mfa_configuration").(string) == cognitoidentityprovider.UserPoolMfaTypeOff {
+		return nil
+	}
+	if !diffValueSet(d, "sms_configuration") {
+		return fmt.Errorf("%q: required field is not set when mfa_configuration is %s",
+			"sms_configuration", d.Get("mfa_configuration").(string))
+	}
+	return nil
+}
+
+// cognitoUserPoolArn returns the ARN of a user pool, which isn't returned by
+// DescribeUserPool.
+func cognitoUserPoolArn(client *AWSClient, id string) string {
+	arn := awsArn{
+		Partition: client.partition,
+		Service:   "cognito-idp",
+		Region:    client.region,
+		AccountID: client.accountid,
+		Resource:  "userpool/" + id,
+	}
+	return arn.String()
+}
+
+func isCognitoUserPoolSmsRoleError(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case "InvalidSmsRoleAccessPolicyException", "InvalidSmsRoleTrustRelationshipException":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCognitoUserPoolClient() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolClientCreate,
+		Read:   resourceAwsCognitoUserPoolClientRead,
+		Update: resourceAwsCognitoUserPoolClientUpdate,
+		Delete: resourceAwsCognitoUserPoolClientDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCognitoUserPoolClientImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCognitoUserPoolClientName,
+			},
+
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"generate_secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"allowed_oauth_flows": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.OAuthFlowTypeCode,
+						cognitoidentityprovider.OAuthFlowTypeImplicit,
+						cognitoidentityprovider.OAuthFlowTypeClientCredentials,
+					}, false),
+				},
+			},
+
+			"allowed_oauth_flows_user_pool_client": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"allowed_oauth_scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"callback_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+
+			"default_redirect_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"explicit_auth_flows": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.ExplicitAuthFlowsTypeAdminNoSrpAuth,
+						cognitoidentityprovider.ExplicitAuthFlowsTypeCustomAuthFlowOnly,
+					}, false),
+				},
+			},
+
+			"logout_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+
+			"read_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"refresh_token_validity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(0, 3650),
+			},
+
+			"supported_identity_providers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"write_attributes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolClientCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	params := &cognitoidentityprovider.CreateUserPoolClientInput{
+		ClientName:                      aws.String(d.Get("name").(string)),
+		UserPoolId:                      aws.String(d.Get("user_pool_id").(string)),
+		GenerateSecret:                  aws.Bool(d.Get("generate_secret").(bool)),
+		AllowedOAuthFlowsUserPoolClient: aws.Bool(d.Get("allowed_oauth_flows_user_pool_client").(bool)),
+		RefreshTokenValidity:            aws.Int64(int64(d.Get("refresh_token_validity").(int))),
+	}
+
+	if v, ok := d.GetOk("allowed_oauth_flows"); ok {
+		params.AllowedOAuthFlows = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("allowed_oauth_scopes"); ok {
+		params.AllowedOAuthScopes = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("callback_urls"); ok {
+		params.CallbackURLs = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("default_redirect_uri"); ok {
+		params.DefaultRedirectURI = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("explicit_auth_flows"); ok {
+		params.ExplicitAuthFlows = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("logout_urls"); ok {
+		params.LogoutURLs = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("read_attributes"); ok {
+		params.ReadAttributes = expandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("supported_identity_providers"); ok {
+		params.SupportedIdentityProviders = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("write_attributes"); ok {
+		params.WriteAttributes = expandStringList(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Cognito User Pool Client: %s", params)
+
+	resp, err := conn.CreateUserPoolClient(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Cognito User Pool Client: {{err}}", err)
+	}
+
+	d.SetId(*resp.UserPoolClient.ClientId)
+
+	return resourceAwsCognitoUserPoolClientRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolClientRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Reading Cognito User Pool Client: %s", d.Id())
+
+	resp, err := conn.DescribeUserPoolClient(&cognitoidentityprovider.DescribeUserPoolClientInput{
+		ClientId:   aws.String(d.Id()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			log.Printf("[WARN] Cognito User Pool Client %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	client := resp.UserPoolClient
+
+	d.Set("name", client.ClientName)
+	d.Set("user_pool_id", client.UserPoolId)
+	d.Set("client_secret", client.ClientSecret)
+	d.Set("generate_secret", client.ClientSecret != nil)
+	d.Set("allowed_oauth_flows_user_pool_client", client.AllowedOAuthFlowsUserPoolClient)
+	d.Set("default_redirect_uri", client.DefaultRedirectURI)
+	d.Set("refresh_token_validity", client.RefreshTokenValidity)
+
+	for k, v := range map[string][]*string{
+		"allowed_oauth_flows":          client.AllowedOAuthFlows,
+		"allowed_oauth_scopes":         client.AllowedOAuthScopes,
+		"callback_urls":                client.CallbackURLs,
+		"explicit_auth_flows":          client.ExplicitAuthFlows,
+		"logout_urls":                  client.LogoutURLs,
+		"read_attributes":              client.ReadAttributes,
+		"supported_identity_providers": client.SupportedIdentityProviders,
+		"write_attributes":             client.WriteAttributes,
+	} {
+		if err := d.Set(k, flattenStringList(v)); err != nil {
+			return fmt.Errorf("Error setting %s: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolClientUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	// UpdateUserPoolClient resets every setting that isn't given to its
+	// default, so the whole configuration is sent on every update.
+	params := &cognitoidentityprovider.UpdateUserPoolClientInput{
+		ClientId:                        aws.String(d.Id()),
+		UserPoolId:                      aws.String(d.Get("user_pool_id").(string)),
+		ClientName:                      aws.String(d.Get("name").(string)),
+		AllowedOAuthFlows:               expandStringList(d.Get("allowed_oauth_flows").(*schema.Set).List()),
+		AllowedOAuthFlowsUserPoolClient: aws.Bool(d.Get("allowed_oauth_flows_user_pool_client").(bool)),
+		AllowedOAuthScopes:              expandStringList(d.Get("allowed_oauth_scopes").(*schema.Set).List()),
+		CallbackURLs:                    expandStringList(d.Get("callback_urls").([]interface{})),
+		ExplicitAuthFlows:               expandStringList(d.Get("explicit_auth_flows").(*schema.Set).List()),
+		LogoutURLs:                      expandStringList(d.Get("logout_urls").([]interface{})),
+		ReadAttributes:                  expandStringList(d.Get("read_attributes").(*schema.Set).List()),
+		RefreshTokenValidity:            aws.Int64(int64(d.Get("refresh_token_validity").(int))),
+		SupportedIdentityProviders:      expandStringList(d.Get("supported_identity_providers").([]interface{})),
+		WriteAttributes:                 expandStringList(d.Get("write_attributes").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("default_redirect_uri"); ok {
+		params.DefaultRedirectURI = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Cognito User Pool Client: %s", params)
+
+	_, err := conn.UpdateUserPoolClient(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Cognito User Pool Client: {{err}}", err)
+	}
+
+	return resourceAwsCognitoUserPoolClientRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolClientDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Deleting Cognito User Pool Client: %s", d.Id())
+
+	_, err := conn.DeleteUserPoolClient(&cognitoidentityprovider.DeleteUserPoolClientInput{
+		ClientId:   aws.String(d.Id()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Cognito User Pool Client: {{err}}", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolClientImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'user-pool-id/client-id'", d.Id())
+	}
+
+	d.Set("user_pool_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
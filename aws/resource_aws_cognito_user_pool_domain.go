@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCognitoUserPoolDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolDomainCreate,
+		Read:   resourceAwsCognitoUserPoolDomainRead,
+		Delete: resourceAwsCognitoUserPoolDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolDomain,
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudfront_distribution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	domain := d.Get("domain").(string)
+
+	params := &cognitoidentityprovider.CreateUserPoolDomainInput{
+		Domain:     aws.String(domain),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	}
+	log.Printf("[DEBUG] Creating Cognito User Pool Domain: %s", params)
+
+	_, err := conn.CreateUserPoolDomain(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Cognito User Pool Domain: {{err}}", err)
+	}
+
+	d.SetId(domain)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cognitoidentityprovider.DomainStatusTypeCreating,
+			cognitoidentityprovider.DomainStatusTypeUpdating,
+		},
+		Target: []string{
+			cognitoidentityprovider.DomainStatusTypeActive,
+		},
+		Refresh:    cognitoUserPoolDomainStateRefreshFunc(conn, domain),
+		Timeout:    20 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf("Error waiting for Cognito User Pool Domain to become active: {{err}}", err)
+	}
+
+	return resourceAwsCognitoUserPoolDomainRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Reading Cognito User Pool Domain: %s", d.Id())
+
+	domain, err := conn.DescribeUserPoolDomain(&cognitoidentityprovider.DescribeUserPoolDomainInput{
+		Domain: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			log.Printf("[WARN] Cognito User Pool Domain %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	desc := domain.DomainDescription
+	// An empty description is returned for domains that don't exist.
+	if desc == nil || desc.Domain == nil {
+		log.Printf("[WARN] Cognito User Pool Domain %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("domain", d.Id())
+	d.Set("aws_account_id", desc.AWSAccountId)
+	d.Set("cloudfront_distribution_arn", desc.CloudFrontDistribution)
+	d.Set("s3_bucket", desc.S3Bucket)
+	d.Set("user_pool_id", desc.UserPoolId)
+	d.Set("version", desc.Version)
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	log.Printf("[DEBUG] Deleting Cognito User Pool Domain: %s", d.Id())
+
+	_, err := conn.DeleteUserPoolDomain(&cognitoidentityprovider.DeleteUserPoolDomainInput{
+		Domain:     aws.String(d.Id()),
+		UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Cognito User Pool Domain: {{err}}", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cognitoidentityprovider.DomainStatusTypeUpdating,
+			cognitoidentityprovider.DomainStatusTypeDeleting,
+			cognitoidentityprovider.DomainStatusTypeActive,
+		},
+		Target:     []string{""},
+		Refresh:    cognitoUserPoolDomainStateRefreshFunc(conn, d.Id()),
+		Timeout:    20 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf("Error waiting for Cognito User Pool Domain to be deleted: {{err}}", err)
+	}
+
+	return nil
+}
+
+// cognitoUserPoolDomainStateRefreshFunc returns the status of a domain, or an
+// empty status once the domain is gone.
+func cognitoUserPoolDomainStateRefreshFunc(conn *cognitoidentityprovider.CognitoIdentityProvider, domain string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeUserPoolDomain(&cognitoidentityprovider.DescribeUserPoolDomainInput{
+			Domain: aws.String(domain),
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+				return 42, "", nil
+			}
+			return nil, "", err
+		}
+
+		desc := resp.DomainDescription
+		if desc == nil || desc.Status == nil {
+			return 42, "", nil
+		}
+		if *desc.Status == cognitoidentityprovider.DomainStatusTypeFailed {
+			return nil, *desc.Status, fmt.Errorf("Cognito User Pool Domain %s failed", domain)
+		}
+
+		return desc, *desc.Status, nil
+	}
+}
@@ -0,0 +1,366 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCognitoUserPool_basic(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "name", "terraform-test-pool-"+name),
+					resource.TestMatchResourceAttr("aws_cognito_user_pool.pool", "arn",
+						regexp.MustCompile(`^arn:aws:cognito-idp:[^:]+:[0-9]{12}:userpool/.+$`)),
+					resource.TestMatchResourceAttr("aws_cognito_user_pool.pool", "endpoint",
+						regexp.MustCompile(`^cognito-idp\.[^.]+\.amazonaws.com/[\w-]+_[0-9a-zA-Z]+$`)),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OFF"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.minimum_length", "8"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "admin_create_user_config.0.unused_account_validity_days", "7"),
+					resource.TestCheckResourceAttrSet("aws_cognito_user_pool.pool", "creation_date"),
+					resource.TestCheckResourceAttrSet("aws_cognito_user_pool.pool", "last_modified_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withPasswordPolicy(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withPasswordPolicy(name, 7, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.minimum_length", "7"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_lowercase", "true"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_numbers", "false"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_symbols", "true"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_uppercase", "false"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withPasswordPolicy(name, 9, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.minimum_length", "9"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "password_policy.0.require_lowercase", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withLambdaConfig(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withLambdaConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "lambda_config.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_cognito_user_pool.pool", "lambda_config.0.pre_sign_up", "aws_lambda_function.main", "arn"),
+					resource.TestCheckResourceAttrPair("aws_cognito_user_pool.pool", "lambda_config.0.custom_message", "aws_lambda_function.main", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withLambdaConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "lambda_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withSchemaAttributes(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSchemaAttributes(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "schema.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withSmsMfa(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSmsMfa(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OPTIONAL"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "sms_authentication_message", "Your code is {####}"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "sms_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "sms_configuration.0.external_id", name),
+					resource.TestCheckResourceAttrPair("aws_cognito_user_pool.pool", "sms_configuration.0.sns_caller_arn", "aws_iam_role.main", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Cognito User Pool ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		_, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCognitoUserPoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool" {
+			continue
+		}
+
+		_, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			if wserr, ok := err.(awserr.Error); ok && wserr.Code() == "ResourceNotFoundException" {
+				continue
+			}
+			return err
+		}
+		return fmt.Errorf("Cognito User Pool %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCognitoUserPoolConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+}
+`, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_withPasswordPolicy(name string, length int, lowercase bool) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  password_policy {
+    minimum_length    = %d
+    require_lowercase = %t
+    require_numbers   = false
+    require_symbols   = true
+    require_uppercase = false
+  }
+}
+`, name, length, lowercase)
+}
+
+func testAccAWSCognitoUserPoolConfig_withLambdaConfig(name string, triggers bool) string {
+	lambdaConfig := ""
+	if triggers {
+		lambdaConfig = `
+  lambda_config {
+    custom_message = "${aws_lambda_function.main.arn}"
+    pre_sign_up    = "${aws_lambda_function.main.arn}"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "aws_iam_role" "main" {
+  name = "terraform-test-lambda-%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "main" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "terraform-test-lambda-%[1]s"
+  role          = "${aws_iam_role.main.arn}"
+  handler       = "exports.example"
+  runtime       = "nodejs4.3"
+}
+
+resource "aws_lambda_permission" "cognito" {
+  statement_id  = "AllowExecutionFromCognito"
+  action        = "lambda:InvokeFunction"
+  function_name = "${aws_lambda_function.main.function_name}"
+  principal     = "cognito-idp.amazonaws.com"
+}
+
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%[1]s"
+%[2]s}
+`, name, lambdaConfig)
+}
+
+func testAccAWSCognitoUserPoolConfig_withSchemaAttributes(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  schema {
+    attribute_data_type      = "String"
+    developer_only_attribute = false
+    mutable                  = false
+    name                     = "email"
+    required                 = true
+
+    string_attribute_constraints {
+      min_length = 7
+      max_length = 15
+    }
+  }
+
+  schema {
+    attribute_data_type      = "Number"
+    developer_only_attribute = true
+    mutable                  = true
+    name                     = "mynumber"
+    required                 = false
+
+    number_attribute_constraints {
+      min_value = 2
+      max_value = 6
+    }
+  }
+
+  schema {
+    attribute_data_type = "Boolean"
+    mutable             = false
+    name                = "verified"
+  }
+}
+`, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_withSmsMfa(name string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "main" {
+  name = "terraform-test-sms-%[1]s"
+  path = "/service-role/"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "cognito-idp.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole",
+      "Condition": {
+        "StringEquals": {
+          "sts:ExternalId": "%[1]s"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "main" {
+  name = "terraform-test-sms-%[1]s"
+  role = "${aws_iam_role.main.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "sns:publish"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cognito_user_pool" "pool" {
+  name                       = "terraform-test-pool-%[1]s"
+  mfa_configuration          = "OPTIONAL"
+  sms_authentication_message = "Your code is {####}"
+
+  sms_configuration {
+    external_id    = "%[1]s"
+    sns_caller_arn = "${aws_iam_role.main.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.main"]
+}
+`, name)
+}
@@ -33,7 +33,7 @@ func resourceAwsConfigConfigurationRecorder() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"recording_group": {
 				Type:     schema.TypeList,
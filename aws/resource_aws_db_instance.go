@@ -303,9 +303,10 @@ func resourceAwsDbInstance() *schema.Resource {
 			},
 
 			"monitoring_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"monitoring_interval": {
@@ -325,7 +326,7 @@ func resourceAwsDbInstance() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			"timezone": {
@@ -355,6 +356,18 @@ func resourceAwsDbInstance() *schema.Resource {
 	}
 }
 
+// resourceAwsDbInstanceCustomizeDiff checks the arguments that depend on
+// whether an instance is a replica or restored from a snapshot.
+func resourceAwsDbInstanceCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		validateDiffOnCreate(
+			validateDiffConflictsWith("replicate_source_db", "snapshot_identifier", "username", "password"),
+			validateDiffRequiredWithout([]string{"allocated_storage", "engine", "password", "username"},
+				"replicate_source_db", "snapshot_identifier"),
+		),
+	)
+}
+
 func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
 	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
@@ -29,7 +29,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed:     true,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnOf("dms", "cert"),
 			},
 			"database_name": {
 				Type:     schema.TypeString,
@@ -82,7 +82,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 			"password": {
 				Type:      schema.TypeString,
@@ -61,7 +61,7 @@ func resourceAwsDmsReplicationInstance() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 			"multi_az": {
 				Type:     schema.TypeBool,
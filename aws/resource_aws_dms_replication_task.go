@@ -25,6 +25,12 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cdc_start_time": {
 				Type:     schema.TypeString,
@@ -44,7 +50,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnOf("dms", "rep"),
 			},
 			"replication_task_arn": {
 				Type:     schema.TypeString,
@@ -66,7 +72,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnOf("dms", "endpoint"),
 			},
 			"table_mappings": {
 				Type:             schema.TypeString,
@@ -82,7 +88,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnOf("dms", "endpoint"),
 			},
 		},
 	}
@@ -228,7 +234,7 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 			Pending:    []string{"modifying"},
 			Target:     []string{"ready", "stopped", "failed"},
 			Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      30 * time.Second, // Wait 30 secs before starting
 		}
@@ -268,7 +274,7 @@ func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{
 		Pending:    []string{"deleting"},
 		Target:     []string{},
 		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
@@ -46,7 +46,7 @@ func resourceAwsEbsVolume() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 			"size": {
 				Type:     schema.TypeInt,
@@ -50,9 +50,10 @@ func resourceAwsEcsService() *schema.Resource {
 			},
 
 			"iam_role": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentIamRoleNameArnDiffs,
 			},
 
 			"deployment_maximum_percent": {
@@ -81,9 +82,10 @@ func resourceAwsEcsService() *schema.Resource {
 						},
 
 						"target_group_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateLbTargetGroupArn,
 						},
 
 						"container_name": {
@@ -327,15 +329,8 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("cluster", clusterARN)
 	}
 
-	// Save IAM role in the same format
-	if service.RoleArn != nil {
-		if strings.HasPrefix(d.Get("iam_role").(string), "arn:"+meta.(*AWSClient).partition+":iam:") {
-			d.Set("iam_role", service.RoleArn)
-		} else {
-			roleARN := getNameFromARN(*service.RoleArn)
-			d.Set("iam_role", roleARN)
-		}
-	}
+	// The IAM role may be configured by name, see the DiffSuppressFunc
+	d.Set("iam_role", service.RoleArn)
 
 	if service.DeploymentConfiguration != nil {
 		d.Set("deployment_maximum_percent", service.DeploymentConfiguration.MaximumPercent)
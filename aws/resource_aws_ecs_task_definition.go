@@ -49,9 +49,10 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 			},
 
 			"task_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"network_mode": {
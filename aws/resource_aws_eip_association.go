@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsEipAssociation() *schema.Resource {
 		Create: resourceAwsEipAssociationCreate,
 		Read:   resourceAwsEipAssociationRead,
 		Delete: resourceAwsEipAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEipAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": &schema.Schema{
@@ -169,6 +173,30 @@ func resourceAwsEipAssociationDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceAwsEipAssociationImport accepts the association ID, the public IP
+// of an EC2-Classic EIP or the allocation ID of a VPC EIP, which is resolved to
+// the ID of its current association.
+func resourceAwsEipAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.HasPrefix(d.Id(), "eipalloc-") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	conn := meta.(*AWSClient).ec2conn
+	resp, err := conn.DescribeAddresses(&ec2.DescribeAddressesInput{
+		AllocationIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading EC2 Elastic IP %s: %s", d.Id(), err)
+	}
+	if len(resp.Addresses) == 0 || resp.Addresses[0].AssociationId == nil {
+		return nil, fmt.Errorf("Elastic IP %s is not associated", d.Id())
+	}
+
+	d.SetId(*resp.Addresses[0].AssociationId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func readAwsEipAssociation(d *schema.ResourceData, address *ec2.Address) error {
 	if err := d.Set("allocation_id", address.AllocationId); err != nil {
 		return err
@@ -28,7 +28,7 @@ func resourceAwsElasticTranscoderPipeline() *schema.Resource {
 			"aws_kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			// ContentConfig also requires ThumbnailConfig
@@ -73,8 +73,11 @@ func resourceAwsElastiCacheCommonSchema() map[string]*schema.Schema {
 			Type:     schema.TypeSet,
 			Optional: true,
 			ForceNew: true,
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Set:      schema.HashString,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateArnOf("s3", ""),
+			},
+			Set: schema.HashString,
 		},
 		"snapshot_window": {
 			Type:         schema.TypeString,
@@ -105,8 +108,9 @@ func resourceAwsElastiCacheCommonSchema() map[string]*schema.Schema {
 			ForceNew: true,
 		},
 		"notification_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateSnsTopicArn,
 		},
 
 		"snapshot_retention_limit": {
@@ -23,6 +23,13 @@ func resourceAwsEMRCluster() *schema.Resource {
 		Read:   resourceAwsEMRClusterRead,
 		Update: resourceAwsEMRClusterUpdate,
 		Delete: resourceAwsEMRClusterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -221,9 +228,10 @@ func resourceAwsEMRCluster() *schema.Resource {
 				Optional: true,
 			},
 			"service_role": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentIamRoleNameArnDiffs,
 			},
 			"security_configuration": {
 				Type:     schema.TypeString,
@@ -231,9 +239,10 @@ func resourceAwsEMRCluster() *schema.Resource {
 				Optional: true,
 			},
 			"autoscaling_role": &schema.Schema{
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentIamRoleNameArnDiffs,
 			},
 			"visible_to_all_users": {
 				Type:     schema.TypeBool,
@@ -383,7 +392,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		Pending:    []string{"STARTING", "BOOTSTRAPPING"},
 		Target:     []string{"WAITING", "RUNNING"},
 		Refresh:    resourceAwsEMRClusterStateRefreshFunc(d, meta),
-		Timeout:    75 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
@@ -523,7 +532,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 			Pending:    []string{"STARTING", "BOOTSTRAPPING"},
 			Target:     []string{"WAITING", "RUNNING"},
 			Refresh:    resourceAwsEMRClusterStateRefreshFunc(d, meta),
-			Timeout:    40 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      5 * time.Second,
 		}
@@ -584,7 +593,7 @@ func resourceAwsEMRClusterDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		resp, err := conn.ListInstances(&emr.ListInstancesInput{
 			ClusterId: aws.String(d.Id()),
 		})
@@ -801,10 +810,7 @@ func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
 }
 
 func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); ok {
 		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
 
 		// Set tags
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +16,9 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamGroupMembershipRead,
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -94,6 +98,27 @@ func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// resourceAwsIamGroupMembershipImport accepts either <group-name>/<name> or
+// just <group-name>, in which case the membership is named after the group.
+func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if idParts[0] == "" || (len(idParts) == 2 && idParts[1] == "") {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <group-name>/<name>", d.Id())
+	}
+
+	group := idParts[0]
+	name := group
+	if len(idParts) == 2 {
+		name = idParts[1]
+	}
+
+	d.Set("group", group)
+	d.Set("name", name)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamGroupPolicyAttachmentCreate,
 		Read:   resourceAwsIamGroupPolicyAttachmentRead,
 		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group": &schema.Schema{
@@ -24,9 +28,10 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 				ForceNew: true,
 			},
 			"policy_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamPolicyArn,
 			},
 		},
 	}
@@ -101,6 +106,22 @@ func resourceAwsIamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsIamGroupPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <group-name>/<policy_arn>", d.Id())
+	}
+
+	groupName := idParts[0]
+	policyARN := idParts[1]
+
+	d.Set("group", groupName)
+	d.Set("policy_arn", policyARN)
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", groupName)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToGroup(conn *iam.IAM, group string, arn string) error {
 	_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
 		GroupName: aws.String(group),
@@ -19,6 +19,9 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamPolicyAttachmentRead,
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -52,9 +55,10 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"policy_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamPolicyArn,
 			},
 		},
 	}
@@ -189,6 +193,24 @@ func resourceAwsIamPolicyAttachmentDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsIamPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The attachment name is free-form, so split on the start of the policy ARN
+	// rather than on the first slash.
+	idx := strings.Index(d.Id(), "/arn:")
+	if idx < 1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <name>/<policy_arn>", d.Id())
+	}
+
+	name := d.Id()[:idx]
+	policyARN := d.Id()[idx+1:]
+
+	d.Set("name", name)
+	d.Set("policy_arn", policyARN)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func composeErrors(desc string, uErr error, rErr error, gErr error) error {
 	errMsg := fmt.Sprintf(desc)
 	errs := []error{uErr, rErr, gErr}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamRolePolicyAttachmentCreate,
 		Read:   resourceAwsIamRolePolicyAttachmentRead,
 		Delete: resourceAwsIamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamRolePolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role": &schema.Schema{
@@ -24,9 +28,10 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 				ForceNew: true,
 			},
 			"policy_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamPolicyArn,
 			},
 		},
 	}
@@ -103,6 +108,22 @@ func resourceAwsIamRolePolicyAttachmentDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsIamRolePolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <role-name>/<policy_arn>", d.Id())
+	}
+
+	roleName := idParts[0]
+	policyARN := idParts[1]
+
+	d.Set("role", roleName)
+	d.Set("policy_arn", policyARN)
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", roleName)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToRole(conn *iam.IAM, role string, arn string) error {
 	_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
 		RoleName:  aws.String(role),
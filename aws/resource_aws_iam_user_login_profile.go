@@ -18,9 +18,12 @@ import (
 func resourceAwsIamUserLoginProfile() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsIamUserLoginProfileCreate,
-		Read:   schema.Noop,
+		Read:   resourceAwsIamUserLoginProfileRead,
 		Update: schema.Noop,
 		Delete: schema.RemoveFromState,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserLoginProfileImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -156,3 +159,44 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 	d.Set("encrypted_password", encrypted)
 	return nil
 }
+
+func resourceAwsIamUserLoginProfileRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	_, err := iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
+		UserName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchEntity" {
+			log.Printf("[WARN] No IAM User Login Profile for %q found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return errwrap.Wrapf(fmt.Sprintf("Error reading IAM User Login Profile for %q: {{err}}", d.Id()), err)
+	}
+
+	d.Set("user", d.Id())
+	return nil
+}
+
+// resourceAwsIamUserLoginProfileImport imports a login profile by user name.
+// The password can never be read back, so key_fingerprint and
+// encrypted_password stay empty just as they do when Create adopts an
+// existing login profile.
+func resourceAwsIamUserLoginProfileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	resp, err := iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
+		UserName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error importing IAM User Login Profile for %q: {{err}}", d.Id()), err)
+	}
+
+	d.Set("password_reset_required", resp.LoginProfile.PasswordResetRequired)
+	d.Set("password_length", 20)
+	d.Set("key_fingerprint", "")
+	d.Set("encrypted_password", "")
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamUserPolicyAttachmentCreate,
 		Read:   resourceAwsIamUserPolicyAttachmentRead,
 		Delete: resourceAwsIamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
@@ -24,9 +28,10 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 				Required: true,
 			},
 			"policy_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamPolicyArn,
 			},
 		},
 	}
@@ -100,6 +105,22 @@ func resourceAwsIamUserPolicyAttachmentDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsIamUserPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <user-name>/<policy_arn>", d.Id())
+	}
+
+	userName := idParts[0]
+	policyARN := idParts[1]
+
+	d.Set("user", userName)
+	d.Set("policy_arn", policyARN)
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", userName)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToUser(conn *iam.IAM, user string, arn string) error {
 	_, err := conn.AttachUserPolicy(&iam.AttachUserPolicyInput{
 		UserName:  aws.String(user),
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -17,6 +18,9 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 		Read:   resourceAwsIamUserSshKeyRead,
 		Update: resourceAwsIamUserSshKeyUpdate,
 		Delete: resourceAwsIamUserSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserSshKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ssh_public_key_id": &schema.Schema{
@@ -93,9 +97,16 @@ func resourceAwsIamUserSshKeyRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error reading IAM User SSH Key %s: %s", d.Id(), err)
 	}
 
+	d.Set("ssh_public_key_id", getResp.SSHPublicKey.SSHPublicKeyId)
 	d.Set("fingerprint", getResp.SSHPublicKey.Fingerprint)
 	d.Set("status", getResp.SSHPublicKey.Status)
 
+	// IAM normalises the key body, so only fill it in when it isn't known
+	// yet (i.e. on import) to avoid a perpetual diff against the config.
+	if d.Get("public_key").(string) == "" {
+		d.Set("public_key", getResp.SSHPublicKey.SSHPublicKeyBody)
+	}
+
 	return nil
 }
 
@@ -119,7 +130,7 @@ func resourceAwsIamUserSshKeyUpdate(d *schema.ResourceData, meta interface{}) er
 			}
 			return fmt.Errorf("Error updating IAM User SSH Key %s: %s", d.Id(), err)
 		}
-		return resourceAwsIamUserRead(d, meta)
+		return resourceAwsIamUserSshKeyRead(d, meta)
 	}
 	return nil
 }
@@ -139,6 +150,19 @@ func resourceAwsIamUserSshKeyDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceAwsIamUserSshKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <username>/<ssh_public_key_id>/<encoding>", d.Id())
+	}
+
+	d.Set("username", idParts[0])
+	d.Set("encoding", idParts[2])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func validateIamUserSSHKeyEncoding(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	encodingTypes := map[string]bool{
@@ -22,9 +22,10 @@ func resourceAWSInspectorAssessmentTemplate() *schema.Resource {
 				ForceNew: true,
 			},
 			"target_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOf("inspector", "target"),
 			},
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -37,8 +38,11 @@ func resourceAWSInspectorAssessmentTemplate() *schema.Resource {
 				ForceNew: true,
 			},
 			"rules_package_arns": &schema.Schema{
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArnOf("inspector", "rulespackage"),
+				},
 				Set:      schema.HashString,
 				Required: true,
 				ForceNew: true,
@@ -25,13 +25,15 @@ func resourceAwsLambdaEventSourceMapping() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"event_source_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOfServices("dynamodb", "kinesis"),
 			},
 			"function_name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentLambdaFunctionNameArnDiffs,
 			},
 			"starting_position": {
 				Type:     schema.TypeString,
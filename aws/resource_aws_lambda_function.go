@@ -69,7 +69,7 @@ func resourceAwsLambdaFunction() *schema.Resource {
 						"target_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateArnOfServices("sns", "sqs"),
 						},
 					},
 				},
@@ -191,7 +191,7 @@ func resourceAwsLambdaFunction() *schema.Resource {
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			"tags": tagsSchema(),
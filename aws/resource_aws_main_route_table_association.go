@@ -15,6 +15,9 @@ func resourceAwsMainRouteTableAssociation() *schema.Resource {
 		Read:   resourceAwsMainRouteTableAssociationRead,
 		Update: resourceAwsMainRouteTableAssociationUpdate,
 		Delete: resourceAwsMainRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsMainRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -79,11 +82,38 @@ func resourceAwsMainRouteTableAssociationRead(d *schema.ResourceData, meta inter
 	if mainAssociation == nil || *mainAssociation.RouteTableAssociationId != d.Id() {
 		// It seems it doesn't exist anymore, so clear the ID
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("route_table_id", mainAssociation.RouteTableId)
+
 	return nil
 }
 
+// resourceAwsMainRouteTableAssociationImport imports the main route table
+// association of a VPC by the VPC ID. The route table the VPC was created with
+// can't be discovered, so the currently associated table is recorded as the
+// original one and destroying the resource leaves it as the main route table.
+func resourceAwsMainRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+	vpcId := d.Id()
+
+	mainAssociation, err := findMainRouteTableAssociation(conn, vpcId)
+	if err != nil {
+		return nil, err
+	}
+	if mainAssociation == nil {
+		return nil, fmt.Errorf("Could not find main routing table association for VPC: %s", vpcId)
+	}
+
+	d.Set("vpc_id", vpcId)
+	d.Set("route_table_id", mainAssociation.RouteTableId)
+	d.Set("original_route_table_id", mainAssociation.RouteTableId)
+	d.SetId(*mainAssociation.RouteTableAssociationId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // Update is almost exactly like Create, except we want to retain the
 // original_route_table_id - this needs to stay recorded as the AWS-created
 // table from VPC creation.
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Create: resourceAwsNetworkAclRuleCreate,
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkAclRuleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
@@ -240,6 +244,46 @@ func resourceAwsNetworkAclRuleDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceAwsNetworkAclRuleImport imports a rule by an ID of the form
+// <network_acl_id>_<rule_number>_<ingress|egress>, e.g. acl-12345678_100_ingress.
+func resourceAwsNetworkAclRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "_")
+	if len(idParts) != 3 || idParts[0] == "" || (idParts[2] != "ingress" && idParts[2] != "egress") {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NETWORKACLID_RULENUMBER_INGRESS|EGRESS", d.Id())
+	}
+
+	ruleNumber, err := strconv.Atoi(idParts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), invalid rule number %q", d.Id(), idParts[1])
+	}
+	egress := idParts[2] == "egress"
+
+	d.Set("network_acl_id", idParts[0])
+	d.Set("rule_number", ruleNumber)
+	d.Set("egress", egress)
+
+	rule, err := findNetworkAclRule(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, fmt.Errorf("Network ACL rule %q not found", d.Id())
+	}
+
+	// The ID is derived from the protocol as configured, which Read will
+	// normalise to a name where one exists.
+	protocol := *rule.Protocol
+	if p, err := strconv.Atoi(protocol); err == nil {
+		if name, ok := protocolStrings(protocolIntegers())[p]; ok {
+			protocol = name
+		}
+	}
+
+	d.SetId(networkAclIdRuleNumberEgressHash(idParts[0], ruleNumber, egress, protocol))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findNetworkAclRule(d *schema.ResourceData, meta interface{}) (*ec2.NetworkAclEntry, error) {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -131,8 +131,9 @@ func resourceAwsOpsworksApplication() *schema.Resource {
 				Optional: true,
 			},
 			"data_source_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnOf("rds", ""),
 			},
 			"description": {
 				Type:     schema.TypeString,
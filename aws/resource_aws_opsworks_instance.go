@@ -102,9 +102,10 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 			},
 
 			"ecs_cluster_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArnOf("ecs", "cluster"),
 			},
 
 			"elastic_ip": {
@@ -133,9 +134,10 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 			},
 
 			"instance_profile_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArnOf("iam", "instance-profile"),
 			},
 
 			"instance_type": {
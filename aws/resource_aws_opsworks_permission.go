@@ -35,8 +35,9 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 				Optional: true,
 			},
 			"user_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArnOf("iam", "user"),
 			},
 			// one of deny, show, deploy, manage, iam_only
 			"level": {
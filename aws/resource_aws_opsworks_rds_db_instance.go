@@ -30,9 +30,10 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 				ForceNew: true,
 			},
 			"rds_db_instance_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOf("rds", "db"),
 			},
 			"db_password": {
 				Type:      schema.TypeString,
@@ -57,14 +57,16 @@ func resourceAwsOpsworksStack() *schema.Resource {
 			},
 
 			"service_role_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"default_instance_profile_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArnOf("iam", "instance-profile"),
 			},
 
 			"color": {
@@ -24,9 +24,10 @@ func resourceAwsOpsworksUserProfile() *schema.Resource {
 			},
 
 			"user_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOf("iam", "user"),
 			},
 
 			"allow_self_management": {
@@ -215,7 +215,7 @@ func resourceAwsRDSCluster() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			"replication_source_identifier": {
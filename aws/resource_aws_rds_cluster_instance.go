@@ -121,9 +121,10 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 			},
 
 			"monitoring_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"preferred_maintenance_window": {
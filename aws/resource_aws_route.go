@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -27,6 +28,9 @@ func resourceAwsRoute() *schema.Resource {
 		Update: resourceAwsRouteUpdate,
 		Delete: resourceAwsRouteDelete,
 		Exists: resourceAwsRouteExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": {
@@ -450,6 +454,41 @@ func resourceAwsRouteExists(d *schema.ResourceData, meta interface{}) (bool, err
 	return false, nil
 }
 
+// resourceAwsRouteImport imports a route by an ID of the form
+// <route_table_id>_<destination>, where the destination is either an IPv4 or
+// an IPv6 CIDR block, e.g. rtb-12345678_0.0.0.0/0.
+func resourceAwsRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	idParts := strings.SplitN(d.Id(), "_", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ROUTETABLEID_DESTINATION", d.Id())
+	}
+
+	routeTableID := idParts[0]
+	destination := idParts[1]
+
+	d.Set("route_table_id", routeTableID)
+
+	var cidr, ipv6Cidr string
+	if strings.Contains(destination, ":") {
+		ipv6Cidr = destination
+		d.Set("destination_ipv6_cidr_block", ipv6Cidr)
+	} else {
+		cidr = destination
+		d.Set("destination_cidr_block", cidr)
+	}
+
+	route, err := findResourceRoute(conn, routeTableID, cidr, ipv6Cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(routeIDHash(d, route))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // Create an ID for a route
 func routeIDHash(d *schema.ResourceData, r *ec2.Route) string {
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsRouteTableAssociation() *schema.Resource {
 		Read:   resourceAwsRouteTableAssociationRead,
 		Update: resourceAwsRouteTableAssociationUpdate,
 		Delete: resourceAwsRouteTableAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteTableAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"subnet_id": &schema.Schema{
@@ -104,6 +108,45 @@ func resourceAwsRouteTableAssociationRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+// resourceAwsRouteTableAssociationImport imports an association by the ID of
+// the associated subnet or by the association ID.
+func resourceAwsRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	filterName := "association.subnet-id"
+	if strings.HasPrefix(d.Id(), "rtbassoc-") {
+		filterName = "association.route-table-association-id"
+	}
+
+	resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String(filterName),
+				Values: []*string{aws.String(d.Id())},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rt := range resp.RouteTables {
+		for _, a := range rt.Associations {
+			if a.SubnetId == nil {
+				continue
+			}
+			if *a.SubnetId == d.Id() || *a.RouteTableAssociationId == d.Id() {
+				d.Set("subnet_id", a.SubnetId)
+				d.Set("route_table_id", a.RouteTableId)
+				d.SetId(*a.RouteTableAssociationId)
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("No route table association found for %q", d.Id())
+}
+
 func resourceAwsRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -467,8 +467,9 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
-	if err := setTagsS3(s3conn, d); err != nil {
+	client := meta.(*AWSClient)
+	s3conn := client.s3conn
+	if err := setTagsS3(s3conn, d, client.ignoreTagKeys, client.ignoreTagKeyPrefixes); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
 
@@ -50,8 +50,9 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 							Optional: true,
 						},
 						"topic_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
@@ -82,8 +83,9 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 							Optional: true,
 						},
 						"queue_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArnOf("sqs", ""),
 						},
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
@@ -114,8 +116,9 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 							Optional: true,
 						},
 						"lambda_function_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnOf("lambda", "function"),
 						},
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
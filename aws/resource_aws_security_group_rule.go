@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +23,9 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 		Create: resourceAwsSecurityGroupRuleCreate,
 		Read:   resourceAwsSecurityGroupRuleRead,
 		Delete: resourceAwsSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSecurityGroupRuleImport,
+		},
 
 		SchemaVersion: 2,
 		MigrateState:  resourceAwsSecurityGroupRuleMigrateState,
@@ -106,6 +110,13 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 	}
 }
 
+// resourceAwsSecurityGroupRuleCustomizeDiff checks that a rule has a source.
+func resourceAwsSecurityGroupRuleCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		validateDiffAtLeastOneOf("cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids", "source_security_group_id", "self"),
+	)
+}
+
 func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
@@ -334,6 +345,85 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// resourceAwsSecurityGroupRuleImport imports a rule by an ID of the form
+// <security_group_id>_<type>_<protocol>_<from_port>_<to_port>_<source>[_<source>...],
+// where each source is a CIDR block, a prefix list ID, a security group ID or
+// "self", e.g. sg-12345678_ingress_tcp_443_443_10.0.0.0/8.
+func resourceAwsSecurityGroupRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	invalidIDError := func(msg string) error {
+		return fmt.Errorf("Unexpected format of ID (%q), expected SGID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE[_SOURCE]*: %s", d.Id(), msg)
+	}
+
+	parts := strings.Split(d.Id(), "_")
+	if len(parts) < 6 {
+		return nil, invalidIDError("too few parts")
+	}
+
+	sgID, ruleType, protocol := parts[0], parts[1], protocolForValue(parts[2])
+	if ruleType != "ingress" && ruleType != "egress" {
+		return nil, invalidIDError("type must be ingress or egress")
+	}
+
+	fromPort, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return nil, invalidIDError(fmt.Sprintf("invalid from port %q", parts[3]))
+	}
+	toPort, err := strconv.Atoi(parts[4])
+	if err != nil {
+		return nil, invalidIDError(fmt.Sprintf("invalid to port %q", parts[4]))
+	}
+
+	var cidrs, ipv6Cidrs, prefixLists []string
+	var sourceSG string
+	self := false
+	for _, source := range parts[5:] {
+		switch {
+		case source == "self":
+			self = true
+		case strings.HasPrefix(source, "pl-"):
+			prefixLists = append(prefixLists, source)
+		case strings.HasPrefix(source, "sg-") || strings.Contains(source, "/sg-"):
+			if sourceSG != "" {
+				return nil, invalidIDError("only one source security group may be given")
+			}
+			sourceSG = source
+		case strings.Contains(source, ":"):
+			ipv6Cidrs = append(ipv6Cidrs, source)
+		case strings.Contains(source, "/"):
+			cidrs = append(cidrs, source)
+		default:
+			return nil, invalidIDError(fmt.Sprintf("unrecognized source %q", source))
+		}
+	}
+
+	d.Set("security_group_id", sgID)
+	d.Set("type", ruleType)
+	d.Set("protocol", protocol)
+	d.Set("from_port", fromPort)
+	d.Set("to_port", toPort)
+	d.Set("cidr_blocks", cidrs)
+	d.Set("ipv6_cidr_blocks", ipv6Cidrs)
+	d.Set("prefix_list_ids", prefixLists)
+	d.Set("source_security_group_id", sourceSG)
+	d.Set("self", self)
+
+	sg, err := findResourceSecurityGroup(conn, sgID)
+	if err != nil {
+		return nil, err
+	}
+
+	perm, err := expandIPPerm(d, sg)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(ipPermissionIDHash(sgID, ruleType, perm))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findResourceSecurityGroup(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
 	req := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: []*string{aws.String(id)},
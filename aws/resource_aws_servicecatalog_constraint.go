@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogConstraintCreate,
+		Read:   resourceAwsServiceCatalogConstraintRead,
+		Update: resourceAwsServiceCatalogConstraintUpdate,
+		Delete: resourceAwsServiceCatalogConstraintDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsServiceCatalogConstraintImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LAUNCH",
+					"NOTIFICATION",
+					"TEMPLATE",
+				}, false),
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2000),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.CreateConstraintInput{
+		PortfolioId: aws.String(d.Get("portfolio_id").(string)),
+		ProductId:   aws.String(d.Get("product_id").(string)),
+		Type:        aws.String(d.Get("type").(string)),
+		Parameters:  aws.String(d.Get("parameters").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Constraint: %s", params)
+
+	resp, err := conn.CreateConstraint(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Service Catalog Constraint: {{err}}", err)
+	}
+
+	d.SetId(*resp.ConstraintDetail.ConstraintId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{servicecatalog.StatusCreating},
+		Target:     []string{servicecatalog.StatusAvailable},
+		Refresh:    serviceCatalogConstraintStateRefreshFunc(conn, d.Id()),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf("Error waiting for Service Catalog Constraint to become available: {{err}}", err)
+	}
+
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Constraint: %s", d.Id())
+
+	resp, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Constraint %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// The portfolio and the product the constraint applies to aren't
+	// returned, so they're kept from the configuration.
+	d.Set("type", resp.ConstraintDetail.Type)
+	d.Set("description", resp.ConstraintDetail.Description)
+	d.Set("owner", resp.ConstraintDetail.Owner)
+	d.Set("parameters", resp.ConstraintParameters)
+	d.Set("status", resp.Status)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.UpdateConstraintInput{
+		Id:          aws.String(d.Id()),
+		Description: aws.String(d.Get("description").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Constraint: %s", params)
+
+	_, err := conn.UpdateConstraint(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Service Catalog Constraint: {{err}}", err)
+	}
+
+	return resourceAwsServiceCatalogConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Deleting Service Catalog Constraint: %s", d.Id())
+
+	_, err := conn.DeleteConstraint(&servicecatalog.DeleteConstraintInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Service Catalog Constraint: {{err}}", err)
+	}
+
+	return nil
+}
+
+// resourceAwsServiceCatalogConstraintImport imports a constraint from the
+// portfolio ID, the product ID and the constraint ID separated by slashes,
+// since AWS doesn't return the portfolio and product of a constraint.
+func resourceAwsServiceCatalogConstraintImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Wrong format of resource: %s. Please follow 'portfolio-id/product-id/constraint-id'", d.Id())
+	}
+
+	d.Set("portfolio_id", parts[0])
+	d.Set("product_id", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func serviceCatalogConstraintStateRefreshFunc(conn *servicecatalog.ServiceCatalog, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(id),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(resp.Status)
+		if status == servicecatalog.StatusFailed {
+			return nil, status, fmt.Errorf("Service Catalog Constraint %s failed to be created", id)
+		}
+
+		return resp, status, nil
+	}
+}
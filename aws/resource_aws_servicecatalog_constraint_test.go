@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogConstraint_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogConstraintConfig(name, "test-description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists("aws_servicecatalog_constraint.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_constraint.test", "type", "LAUNCH"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_constraint.test", "description", "test-description"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_constraint.test", "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet("aws_servicecatalog_constraint.test", "owner"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogConstraintConfig(name, "test-description-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists("aws_servicecatalog_constraint.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_constraint.test", "description", "test-description-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogConstraintExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Constraint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+		_, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogConstraintDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_constraint" {
+			continue
+		}
+
+		_, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Constraint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogConstraintConfig(name, description string) string {
+	return testAccAWSServiceCatalogProductPortfolioAssociationConfig(name) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "tf-test-servicecatalog-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "servicecatalog.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_servicecatalog_constraint" "test" {
+  portfolio_id = "${aws_servicecatalog_product_portfolio_association.test.portfolio_id}"
+  product_id   = "${aws_servicecatalog_product_portfolio_association.test.product_id}"
+  type         = "LAUNCH"
+  description  = "%s"
+
+  parameters = <<EOF
+{
+  "RoleArn": "${aws_iam_role.test.arn}"
+}
+EOF
+}
+`, name, description)
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogPortfolio() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPortfolioCreate,
+		Read:   resourceAwsServiceCatalogPortfolioRead,
+		Update: resourceAwsServiceCatalogPortfolioUpdate,
+		Delete: resourceAwsServiceCatalogPortfolioDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2000),
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.CreatePortfolioInput{
+		DisplayName:  aws.String(d.Get("name").(string)),
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		Tags:         tagsFromMapServiceCatalog(d.Get("tags").(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Portfolio: %s", params)
+
+	resp, err := conn.CreatePortfolio(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Service Catalog Portfolio: {{err}}", err)
+	}
+
+	d.SetId(*resp.PortfolioDetail.Id)
+
+	return resourceAwsServiceCatalogPortfolioRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Portfolio: %s", d.Id())
+
+	resp, err := conn.DescribePortfolio(&servicecatalog.DescribePortfolioInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Portfolio %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	portfolio := resp.PortfolioDetail
+	d.Set("name", portfolio.DisplayName)
+	d.Set("description", portfolio.Description)
+	d.Set("provider_name", portfolio.ProviderName)
+	d.Set("arn", portfolio.ARN)
+	if portfolio.CreatedTime != nil {
+		d.Set("created_time", portfolio.CreatedTime.Format(time.RFC3339))
+	}
+
+	if err := d.Set("tags", tagsToMapServiceCatalog(resp.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.UpdatePortfolioInput{
+		Id:           aws.String(d.Id()),
+		DisplayName:  aws.String(d.Get("name").(string)),
+		ProviderName: aws.String(d.Get("provider_name").(string)),
+		Description:  aws.String(d.Get("description").(string)),
+	}
+
+	if o, n, ok := tagsChange(d); ok {
+		params.AddTags, params.RemoveTags = diffTagsServiceCatalog(tagsFromMapServiceCatalog(o), tagsFromMapServiceCatalog(n))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Portfolio: %s", params)
+
+	_, err := conn.UpdatePortfolio(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Service Catalog Portfolio: {{err}}", err)
+	}
+
+	return resourceAwsServiceCatalogPortfolioRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Deleting Service Catalog Portfolio: %s", d.Id())
+
+	_, err := conn.DeletePortfolio(&servicecatalog.DeletePortfolioInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Service Catalog Portfolio: {{err}}", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogPortfolioShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPortfolioShareCreate,
+		Read:   resourceAwsServiceCatalogPortfolioShareRead,
+		Delete: resourceAwsServiceCatalogPortfolioShareDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	portfolioId := d.Get("portfolio_id").(string)
+	accountId := d.Get("account_id").(string)
+
+	params := &servicecatalog.CreatePortfolioShareInput{
+		PortfolioId: aws.String(portfolioId),
+		AccountId:   aws.String(accountId),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Portfolio Share: %s", params)
+
+	_, err := conn.CreatePortfolioShare(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Service Catalog Portfolio Share: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", portfolioId, accountId))
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Portfolio Share: %s", d.Id())
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("Wrong format of resource: %s. Please follow 'portfolio-id/account-id'", d.Id())
+	}
+	portfolioId, accountId := parts[0], parts[1]
+
+	resp, err := conn.ListPortfolioAccess(&servicecatalog.ListPortfolioAccessInput{
+		PortfolioId: aws.String(portfolioId),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Portfolio %s not found, removing share from state", portfolioId)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	found := false
+	for _, id := range resp.AccountIds {
+		if aws.StringValue(id) == accountId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Service Catalog Portfolio Share %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioId)
+	d.Set("account_id", accountId)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Deleting Service Catalog Portfolio Share: %s", d.Id())
+
+	_, err := conn.DeletePortfolioShare(&servicecatalog.DeletePortfolioShareInput{
+		PortfolioId: aws.String(d.Get("portfolio_id").(string)),
+		AccountId:   aws.String(d.Get("account_id").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Service Catalog Portfolio Share: {{err}}", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The portfolio is shared with the account given by AWS_ACCOUNT_ID, which must
+// be different from the account running the tests.
+func TestAccAWSServiceCatalogPortfolioShare_basic(t *testing.T) {
+	name := acctest.RandString(5)
+	accountId := os.Getenv("AWS_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("AWS_ACCOUNT_ID") == "" {
+				t.Fatal("AWS_ACCOUNT_ID must be set")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfig(name, accountId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPortfolioShareExists("aws_servicecatalog_portfolio_share.test"),
+					resource.TestCheckResourceAttrPair("aws_servicecatalog_portfolio_share.test", "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio_share.test", "account_id", accountId),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccAWSServiceCatalogPortfolioShared(rs.Primary.Attributes["portfolio_id"], rs.Primary.Attributes["account_id"])
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Service Catalog Portfolio Share %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_portfolio_share" {
+			continue
+		}
+
+		found, err := testAccAWSServiceCatalogPortfolioShared(rs.Primary.Attributes["portfolio_id"], rs.Primary.Attributes["account_id"])
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Service Catalog Portfolio Share %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogPortfolioShared(portfolioId, accountId string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	resp, err := conn.ListPortfolioAccess(&servicecatalog.ListPortfolioAccessInput{
+		PortfolioId: aws.String(portfolioId),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range resp.AccountIds {
+		if aws.StringValue(id) == accountId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSServiceCatalogPortfolioShareConfig(name, accountId string) string {
+	return testAccAWSServiceCatalogPortfolioConfig_basic(name) + fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio_share" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  account_id   = "%s"
+}
+`, accountId)
+}
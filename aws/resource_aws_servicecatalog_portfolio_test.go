@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogPortfolio_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPortfolioDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPortfolioExists("aws_servicecatalog_portfolio.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "name", "tf-test-portfolio-"+name),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "description", "test-description"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "provider_name", "test-provider"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "tags.Key1", "Value One"),
+					resource.TestCheckResourceAttrSet("aws_servicecatalog_portfolio.test", "arn"),
+					resource.TestCheckResourceAttrSet("aws_servicecatalog_portfolio.test", "created_time"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogPortfolioConfig_updated(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPortfolioExists("aws_servicecatalog_portfolio.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "name", "tf-test-portfolio-updated-"+name),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "description", "test-description-updated"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "provider_name", "test-provider-updated"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "tags.Key1", "Value 1"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_portfolio.test", "tags.Key2", "Value Two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPortfolioExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Portfolio ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+		_, err := conn.DescribePortfolio(&servicecatalog.DescribePortfolioInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogPortfolioDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_portfolio" {
+			continue
+		}
+
+		_, err := conn.DescribePortfolio(&servicecatalog.DescribePortfolioInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Portfolio %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogPortfolioConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "tf-test-portfolio-%s"
+  description   = "test-description"
+  provider_name = "test-provider"
+
+  tags {
+    Key1 = "Value One"
+  }
+}
+`, name)
+}
+
+func testAccAWSServiceCatalogPortfolioConfig_updated(name string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = "tf-test-portfolio-updated-%s"
+  description   = "test-description-updated"
+  provider_name = "test-provider-updated"
+
+  tags {
+    Key1 = "Value 1"
+    Key2 = "Value Two"
+  }
+}
+`, name)
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogPrincipalPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnOf("iam", ""),
+			},
+			"principal_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.PrincipalTypeIam,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.PrincipalTypeIam,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	portfolioId := d.Get("portfolio_id").(string)
+	principalArn := d.Get("principal_arn").(string)
+
+	params := &servicecatalog.AssociatePrincipalWithPortfolioInput{
+		PortfolioId:   aws.String(portfolioId),
+		PrincipalARN:  aws.String(principalArn),
+		PrincipalType: aws.String(d.Get("principal_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Associating principal with Service Catalog Portfolio: %s", params)
+
+	_, err := conn.AssociatePrincipalWithPortfolio(params)
+	if err != nil {
+		return errwrap.Wrapf("Error associating principal with Service Catalog Portfolio: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", portfolioId, principalArn))
+
+	return resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Principal Portfolio Association: %s", d.Id())
+
+	// The principal ARN contains slashes, so the ID is split at the first one.
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("Wrong format of resource: %s. Please follow 'portfolio-id/principal-arn'", d.Id())
+	}
+	portfolioId, principalArn := parts[0], parts[1]
+
+	var principal *servicecatalog.Principal
+	params := &servicecatalog.ListPrincipalsForPortfolioInput{
+		PortfolioId: aws.String(portfolioId),
+	}
+	for {
+		resp, err := conn.ListPrincipalsForPortfolio(params)
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				log.Printf("[WARN] Service Catalog Portfolio %s not found, removing principal association from state", portfolioId)
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+
+		for _, p := range resp.Principals {
+			if aws.StringValue(p.PrincipalARN) == principalArn {
+				principal = p
+				break
+			}
+		}
+		if principal != nil || resp.NextPageToken == nil {
+			break
+		}
+		params.PageToken = resp.NextPageToken
+	}
+
+	if principal == nil {
+		log.Printf("[WARN] Service Catalog Principal Portfolio Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioId)
+	d.Set("principal_arn", principal.PrincipalARN)
+	d.Set("principal_type", principal.PrincipalType)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Deleting Service Catalog Principal Portfolio Association: %s", d.Id())
+
+	_, err := conn.DisassociatePrincipalFromPortfolio(&servicecatalog.DisassociatePrincipalFromPortfolioInput{
+		PortfolioId:  aws.String(d.Get("portfolio_id").(string)),
+		PrincipalARN: aws.String(d.Get("principal_arn").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error disassociating principal from Service Catalog Portfolio: {{err}}", err)
+	}
+
+	return nil
+}
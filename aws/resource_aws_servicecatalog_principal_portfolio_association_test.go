@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogPrincipalPortfolioAssociation_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPrincipalPortfolioAssociationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationExists("aws_servicecatalog_principal_portfolio_association.test"),
+					resource.TestCheckResourceAttrPair("aws_servicecatalog_principal_portfolio_association.test", "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair("aws_servicecatalog_principal_portfolio_association.test", "principal_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_principal_portfolio_association.test", "principal_type", "IAM"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccAWSServiceCatalogPrincipalAssociated(rs.Primary.Attributes["portfolio_id"], rs.Primary.Attributes["principal_arn"])
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Service Catalog Principal Portfolio Association %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_principal_portfolio_association" {
+			continue
+		}
+
+		found, err := testAccAWSServiceCatalogPrincipalAssociated(rs.Primary.Attributes["portfolio_id"], rs.Primary.Attributes["principal_arn"])
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Service Catalog Principal Portfolio Association %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogPrincipalAssociated(portfolioId, principalArn string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	resp, err := conn.ListPrincipalsForPortfolio(&servicecatalog.ListPrincipalsForPortfolioInput{
+		PortfolioId: aws.String(portfolioId),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, principal := range resp.Principals {
+		if aws.StringValue(principal.PrincipalARN) == principalArn {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSServiceCatalogPrincipalPortfolioAssociationConfig(name string) string {
+	return testAccAWSServiceCatalogPortfolioConfig_basic(name) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = "tf-test-servicecatalog-%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = "${aws_servicecatalog_portfolio.test.id}"
+  principal_arn = "${aws_iam_role.test.arn}"
+}
+`, name)
+}
@@ -0,0 +1,371 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductCreate,
+		Read:   resourceAwsServiceCatalogProductRead,
+		Update: resourceAwsServiceCatalogProductUpdate,
+		Delete: resourceAwsServiceCatalogProductDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8191),
+			},
+			"owner": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8191),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.ProductTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProductTypeCloudFormationTemplate,
+				}, false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"distributor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"support_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8191),
+			},
+			"support_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 254),
+			},
+			"support_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2083),
+			},
+
+			// The provisioning artifact, i.e. the version of the product,
+			// that is managed. A new template registers a new version.
+			"provisioning_artifact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"template_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+							ValidateFunc: validation.StringInSlice([]string{
+								servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+							}, false),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_default_path": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.CreateProductInput{
+		Name:        aws.String(d.Get("name").(string)),
+		Owner:       aws.String(d.Get("owner").(string)),
+		ProductType: aws.String(d.Get("type").(string)),
+		ProvisioningArtifactParameters: expandServiceCatalogProvisioningArtifactProperties(
+			d.Get("provisioning_artifact").([]interface{})),
+		Tags: tagsFromMapServiceCatalog(d.Get("tags").(map[string]interface{})),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		params.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("distributor"); ok {
+		params.Distributor = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("support_description"); ok {
+		params.SupportDescription = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("support_email"); ok {
+		params.SupportEmail = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("support_url"); ok {
+		params.SupportUrl = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product: %s", params)
+
+	resp, err := conn.CreateProduct(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Service Catalog Product: {{err}}", err)
+	}
+
+	d.SetId(*resp.ProductViewDetail.ProductViewSummary.ProductId)
+	if err := d.Set("provisioning_artifact", flattenServiceCatalogProvisioningArtifact(
+		resp.ProvisioningArtifactDetail, d.Get("provisioning_artifact.0.template_url").(string))); err != nil {
+		return fmt.Errorf("Error setting provisioning_artifact: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{servicecatalog.StatusCreating},
+		Target:     []string{servicecatalog.StatusAvailable},
+		Refresh:    serviceCatalogProductStateRefreshFunc(conn, d.Id()),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf("Error waiting for Service Catalog Product to become available: {{err}}", err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Product: %s", d.Id())
+
+	resp, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Product %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	detail := resp.ProductViewDetail
+	product := detail.ProductViewSummary
+	d.Set("name", product.Name)
+	d.Set("owner", product.Owner)
+	d.Set("type", product.Type)
+	d.Set("description", product.ShortDescription)
+	d.Set("distributor", product.Distributor)
+	d.Set("support_description", product.SupportDescription)
+	d.Set("support_email", product.SupportEmail)
+	d.Set("support_url", product.SupportUrl)
+	d.Set("has_default_path", product.HasDefaultPath)
+	d.Set("arn", detail.ProductARN)
+	d.Set("status", detail.Status)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+
+	if err := d.Set("tags", tagsToMapServiceCatalog(resp.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	// When importing, the product's latest provisioning artifact is managed.
+	artifactId := d.Get("provisioning_artifact.0.id").(string)
+	if artifactId == "" {
+		var latest *servicecatalog.ProvisioningArtifactSummary
+		for _, summary := range resp.ProvisioningArtifactSummaries {
+			if latest == nil || (summary.CreatedTime != nil && latest.CreatedTime != nil && summary.CreatedTime.After(*latest.CreatedTime)) {
+				latest = summary
+			}
+		}
+		if latest == nil {
+			return fmt.Errorf("Service Catalog Product %s has no provisioning artifact", d.Id())
+		}
+		artifactId = *latest.Id
+	}
+
+	artifact, err := conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+		ProductId:              aws.String(d.Id()),
+		ProvisioningArtifactId: aws.String(artifactId),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Provisioning Artifact %s not found, removing provisioning_artifact", artifactId)
+			d.Set("provisioning_artifact", nil)
+			return nil
+		}
+		return errwrap.Wrapf("Error reading Service Catalog Provisioning Artifact: {{err}}", err)
+	}
+
+	templateUrl := aws.StringValue(artifact.Info["TemplateUrl"])
+	if templateUrl == "" {
+		templateUrl = d.Get("provisioning_artifact.0.template_url").(string)
+	}
+	if err := d.Set("provisioning_artifact", flattenServiceCatalogProvisioningArtifact(
+		artifact.ProvisioningArtifactDetail, templateUrl)); err != nil {
+		return fmt.Errorf("Error setting provisioning_artifact: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.UpdateProductInput{
+		Id:                 aws.String(d.Id()),
+		Name:               aws.String(d.Get("name").(string)),
+		Owner:              aws.String(d.Get("owner").(string)),
+		Description:        aws.String(d.Get("description").(string)),
+		Distributor:        aws.String(d.Get("distributor").(string)),
+		SupportDescription: aws.String(d.Get("support_description").(string)),
+		SupportEmail:       aws.String(d.Get("support_email").(string)),
+		SupportUrl:         aws.String(d.Get("support_url").(string)),
+	}
+
+	if o, n, ok := tagsChange(d); ok {
+		params.AddTags, params.RemoveTags = diffTagsServiceCatalog(tagsFromMapServiceCatalog(o), tagsFromMapServiceCatalog(n))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Product: %s", params)
+
+	_, err := conn.UpdateProduct(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Service Catalog Product: {{err}}", err)
+	}
+
+	if d.HasChange("provisioning_artifact") {
+		if err := resourceAwsServiceCatalogProductUpdateProvisioningArtifact(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+// resourceAwsServiceCatalogProductUpdateProvisioningArtifact registers a new
+// provisioning artifact when the template changes, keeping the previous
+// versions of the product for the products provisioned from them. Otherwise
+// only the name and description of the artifact are updated.
+func resourceAwsServiceCatalogProductUpdateProvisioningArtifact(conn *servicecatalog.ServiceCatalog, d *schema.ResourceData) error {
+	if d.HasChange("provisioning_artifact.0.template_url") || d.HasChange("provisioning_artifact.0.type") {
+		params := &servicecatalog.CreateProvisioningArtifactInput{
+			ProductId:  aws.String(d.Id()),
+			Parameters: expandServiceCatalogProvisioningArtifactProperties(d.Get("provisioning_artifact").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %s", params)
+
+		resp, err := conn.CreateProvisioningArtifact(params)
+		if err != nil {
+			return errwrap.Wrapf("Error creating Service Catalog Provisioning Artifact: {{err}}", err)
+		}
+
+		if err := d.Set("provisioning_artifact", flattenServiceCatalogProvisioningArtifact(
+			resp.ProvisioningArtifactDetail, d.Get("provisioning_artifact.0.template_url").(string))); err != nil {
+			return fmt.Errorf("Error setting provisioning_artifact: %s", err)
+		}
+		return nil
+	}
+
+	params := &servicecatalog.UpdateProvisioningArtifactInput{
+		ProductId:              aws.String(d.Id()),
+		ProvisioningArtifactId: aws.String(d.Get("provisioning_artifact.0.id").(string)),
+		Name:                   aws.String(d.Get("provisioning_artifact.0.name").(string)),
+		Description:            aws.String(d.Get("provisioning_artifact.0.description").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact: %s", params)
+
+	_, err := conn.UpdateProvisioningArtifact(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Service Catalog Provisioning Artifact: {{err}}", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Deleting Service Catalog Product: %s", d.Id())
+
+	_, err := conn.DeleteProduct(&servicecatalog.DeleteProductInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting Service Catalog Product: {{err}}", err)
+	}
+
+	return nil
+}
+
+func serviceCatalogProductStateRefreshFunc(conn *servicecatalog.ServiceCatalog, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(id),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(resp.ProductViewDetail.Status)
+		if status == servicecatalog.StatusFailed {
+			return nil, status, fmt.Errorf("Service Catalog Product %s failed to be created", id)
+		}
+
+		return resp.ProductViewDetail, status, nil
+	}
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogProductPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogProductPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogProductPortfolioAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_portfolio_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	portfolioId := d.Get("portfolio_id").(string)
+	productId := d.Get("product_id").(string)
+
+	params := &servicecatalog.AssociateProductWithPortfolioInput{
+		PortfolioId: aws.String(portfolioId),
+		ProductId:   aws.String(productId),
+	}
+	if v, ok := d.GetOk("source_portfolio_id"); ok {
+		params.SourcePortfolioId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Associating product with Service Catalog Portfolio: %s", params)
+
+	_, err := conn.AssociateProductWithPortfolio(params)
+	if err != nil {
+		return errwrap.Wrapf("Error associating product with Service Catalog Portfolio: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", portfolioId, productId))
+
+	return resourceAwsServiceCatalogProductPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Product Portfolio Association: %s", d.Id())
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("Wrong format of resource: %s. Please follow 'portfolio-id/product-id'", d.Id())
+	}
+	portfolioId, productId := parts[0], parts[1]
+
+	found := false
+	params := &servicecatalog.ListPortfoliosForProductInput{
+		ProductId: aws.String(productId),
+	}
+	for {
+		resp, err := conn.ListPortfoliosForProduct(params)
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				log.Printf("[WARN] Service Catalog Product %s not found, removing portfolio association from state", productId)
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+
+		for _, portfolio := range resp.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioId {
+				found = true
+				break
+			}
+		}
+		if found || resp.NextPageToken == nil {
+			break
+		}
+		params.PageToken = resp.NextPageToken
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Product Portfolio Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The source portfolio is only used to associate the product, so it's
+	// kept from the configuration.
+	d.Set("portfolio_id", portfolioId)
+	d.Set("product_id", productId)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Deleting Service Catalog Product Portfolio Association: %s", d.Id())
+
+	_, err := conn.DisassociateProductFromPortfolio(&servicecatalog.DisassociateProductFromPortfolioInput{
+		PortfolioId: aws.String(d.Get("portfolio_id").(string)),
+		ProductId:   aws.String(d.Get("product_id").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error disassociating product from Service Catalog Portfolio: {{err}}", err)
+	}
+
+	return nil
+}
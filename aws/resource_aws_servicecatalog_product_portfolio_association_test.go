@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProductPortfolioAssociation_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductPortfolioAssociationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductPortfolioAssociationExists("aws_servicecatalog_product_portfolio_association.test"),
+					resource.TestCheckResourceAttrPair("aws_servicecatalog_product_portfolio_association.test", "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair("aws_servicecatalog_product_portfolio_association.test", "product_id", "aws_servicecatalog_product.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductPortfolioAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccAWSServiceCatalogProductPortfolioAssociated(rs.Primary.Attributes["portfolio_id"], rs.Primary.Attributes["product_id"])
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Service Catalog Product Portfolio Association %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductPortfolioAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product_portfolio_association" {
+			continue
+		}
+
+		found, err := testAccAWSServiceCatalogProductPortfolioAssociated(rs.Primary.Attributes["portfolio_id"], rs.Primary.Attributes["product_id"])
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Service Catalog Product Portfolio Association %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProductPortfolioAssociated(portfolioId, productId string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	resp, err := conn.ListPortfoliosForProduct(&servicecatalog.ListPortfoliosForProductInput{
+		ProductId: aws.String(productId),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, portfolio := range resp.PortfolioDetails {
+		if aws.StringValue(portfolio.Id) == portfolioId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSServiceCatalogProductPortfolioAssociationConfig(name string) string {
+	return testAccAWSServiceCatalogPortfolioConfig_basic(name) +
+		testAccAWSServiceCatalogProductConfig_basic(name) + `
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  product_id   = "${aws_servicecatalog_product.test.id}"
+}
+`
+}
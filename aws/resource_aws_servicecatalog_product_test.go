@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProduct_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists("aws_servicecatalog_product.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "name", "tf-test-product-"+name),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "owner", "test-owner"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "type", "CLOUD_FORMATION_TEMPLATE"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "description", "test-description"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "support_email", "support@example.com"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "provisioning_artifact.#", "1"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "provisioning_artifact.0.name", "v1"),
+					resource.TestCheckResourceAttrSet("aws_servicecatalog_product.test", "provisioning_artifact.0.id"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet("aws_servicecatalog_product.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfig_updated(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists("aws_servicecatalog_product.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "name", "tf-test-product-updated-"+name),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "owner", "test-owner-updated"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "description", "test-description-updated"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "support_email", "help@example.com"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "tags.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProduct_provisioningArtifact(t *testing.T) {
+	name := acctest.RandString(5)
+	var productId, artifactId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists("aws_servicecatalog_product.test"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["aws_servicecatalog_product.test"]
+						productId = rs.Primary.ID
+						artifactId = rs.Primary.Attributes["provisioning_artifact.0.id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfig_provisioningArtifact(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists("aws_servicecatalog_product.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "provisioning_artifact.0.name", "v2"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_product.test", "provisioning_artifact.0.description", "Second version"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["aws_servicecatalog_product.test"]
+						if rs.Primary.ID != productId {
+							return fmt.Errorf("Expected product %s to be kept, got %s", productId, rs.Primary.ID)
+						}
+						if rs.Primary.Attributes["provisioning_artifact.0.id"] == artifactId {
+							return fmt.Errorf("Expected a new provisioning artifact, got %s", artifactId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product" {
+			continue
+		}
+
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Product %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSServiceCatalogProductConfig_template uploads the CloudFormation
+// template the products of the tests are created with.
+func testAccAWSServiceCatalogProductConfig_template(name string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = "tf-test-servicecatalog-%s"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  key    = "template.json"
+
+  content = <<EOF
+{
+  "Resources": {
+    "Vpc": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": "10.1.0.0/16"
+      }
+    }
+  }
+}
+EOF
+}
+`, name)
+}
+
+func testAccAWSServiceCatalogProductConfig_basic(name string) string {
+	return testAccAWSServiceCatalogProductConfig_template(name) + fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  name          = "tf-test-product-%s"
+  owner         = "test-owner"
+  description   = "test-description"
+  support_email = "support@example.com"
+
+  provisioning_artifact {
+    name         = "v1"
+    description  = "First version"
+    template_url = "https://${aws_s3_bucket.test.bucket_domain_name}/${aws_s3_bucket_object.test.key}"
+  }
+
+  tags {
+    Key1 = "Value One"
+  }
+}
+`, name)
+}
+
+func testAccAWSServiceCatalogProductConfig_updated(name string) string {
+	return testAccAWSServiceCatalogProductConfig_template(name) + fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  name          = "tf-test-product-updated-%s"
+  owner         = "test-owner-updated"
+  description   = "test-description-updated"
+  support_email = "help@example.com"
+
+  provisioning_artifact {
+    name         = "v1"
+    description  = "First version"
+    template_url = "https://${aws_s3_bucket.test.bucket_domain_name}/${aws_s3_bucket_object.test.key}"
+  }
+
+  tags {
+    Key1 = "Value 1"
+    Key2 = "Value Two"
+  }
+}
+`, name)
+}
+
+func testAccAWSServiceCatalogProductConfig_provisioningArtifact(name string) string {
+	return testAccAWSServiceCatalogProductConfig_template(name) + fmt.Sprintf(`
+resource "aws_s3_bucket_object" "v2" {
+  bucket = "${aws_s3_bucket.test.id}"
+  key    = "template-v2.json"
+
+  content = <<EOF
+{
+  "Resources": {
+    "Vpc": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": "10.2.0.0/16"
+      }
+    }
+  }
+}
+EOF
+}
+
+resource "aws_servicecatalog_product" "test" {
+  name          = "tf-test-product-%s"
+  owner         = "test-owner"
+  description   = "test-description"
+  support_email = "support@example.com"
+
+  provisioning_artifact {
+    name         = "v2"
+    description  = "Second version"
+    template_url = "https://${aws_s3_bucket.test.bucket_domain_name}/${aws_s3_bucket_object.v2.key}"
+  }
+
+  tags {
+    Key1 = "Value One"
+  }
+}
+`, name)
+}
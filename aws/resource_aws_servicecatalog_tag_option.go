@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogTagOption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionCreate,
+		Read:   resourceAwsServiceCatalogTagOptionRead,
+		Update: resourceAwsServiceCatalogTagOptionUpdate,
+		Delete: resourceAwsServiceCatalogTagOptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.CreateTagOptionInput{
+		Key:   aws.String(d.Get("key").(string)),
+		Value: aws.String(d.Get("value").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Tag Option: %s", params)
+
+	resp, err := conn.CreateTagOption(params)
+	if err != nil {
+		return errwrap.Wrapf("Error creating Service Catalog Tag Option: {{err}}", err)
+	}
+
+	d.SetId(*resp.TagOptionDetail.Id)
+
+	// Tag options are always created active.
+	if !d.Get("active").(bool) {
+		_, err := conn.UpdateTagOption(&servicecatalog.UpdateTagOptionInput{
+			Id:     aws.String(d.Id()),
+			Active: aws.Bool(false),
+		})
+		if err != nil {
+			return errwrap.Wrapf("Error deactivating Service Catalog Tag Option: {{err}}", err)
+		}
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Tag Option: %s", d.Id())
+
+	resp, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+		Id: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Tag Option %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("key", resp.TagOptionDetail.Key)
+	d.Set("value", resp.TagOptionDetail.Value)
+	d.Set("active", resp.TagOptionDetail.Active)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	params := &servicecatalog.UpdateTagOptionInput{
+		Id:     aws.String(d.Id()),
+		Value:  aws.String(d.Get("value").(string)),
+		Active: aws.Bool(d.Get("active").(bool)),
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Tag Option: %s", params)
+
+	_, err := conn.UpdateTagOption(params)
+	if err != nil {
+		return errwrap.Wrapf("Error updating Service Catalog Tag Option: {{err}}", err)
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+
+	// Tag options can't be deleted, so they're deactivated instead, which
+	// keeps them from being applied to provisioned products.
+	log.Printf("[WARN] Service Catalog Tag Option %s can't be deleted, deactivating it", d.Id())
+
+	_, err := conn.UpdateTagOption(&servicecatalog.UpdateTagOptionInput{
+		Id:     aws.String(d.Id()),
+		Active: aws.Bool(false),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error deactivating Service Catalog Tag Option: {{err}}", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogTagOptionResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionResourceAssociationCreate,
+		Read:   resourceAwsServiceCatalogTagOptionResourceAssociationRead,
+		Delete: resourceAwsServiceCatalogTagOptionResourceAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tag_option_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	tagOptionId := d.Get("tag_option_id").(string)
+	resourceId := d.Get("resource_id").(string)
+
+	params := &servicecatalog.AssociateTagOptionWithResourceInput{
+		TagOptionId: aws.String(tagOptionId),
+		ResourceId:  aws.String(resourceId),
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Tag Option with resource: %s", params)
+
+	_, err := conn.AssociateTagOptionWithResource(params)
+	if err != nil {
+		return errwrap.Wrapf("Error associating Service Catalog Tag Option with resource: {{err}}", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", tagOptionId, resourceId))
+
+	return resourceAwsServiceCatalogTagOptionResourceAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Reading Service Catalog Tag Option Resource Association: %s", d.Id())
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("Wrong format of resource: %s. Please follow 'tag-option-id/resource-id'", d.Id())
+	}
+	tagOptionId, resourceId := parts[0], parts[1]
+
+	found := false
+	err := conn.ListResourcesForTagOptionPages(&servicecatalog.ListResourcesForTagOptionInput{
+		TagOptionId: aws.String(tagOptionId),
+	}, func(page *servicecatalog.ListResourcesForTagOptionOutput, lastPage bool) bool {
+		for _, resource := range page.ResourceDetails {
+			if aws.StringValue(resource.Id) == resourceId {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Service Catalog Tag Option %s not found, removing resource association from state", tagOptionId)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Tag Option Resource Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("tag_option_id", tagOptionId)
+	d.Set("resource_id", resourceId)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicecatalogconn
+	log.Printf("[DEBUG] Deleting Service Catalog Tag Option Resource Association: %s", d.Id())
+
+	_, err := conn.DisassociateTagOptionFromResource(&servicecatalog.DisassociateTagOptionFromResourceInput{
+		TagOptionId: aws.String(d.Get("tag_option_id").(string)),
+		ResourceId:  aws.String(d.Get("resource_id").(string)),
+	})
+	if err != nil {
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error disassociating Service Catalog Tag Option from resource: {{err}}", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogTagOptionResourceAssociation_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogTagOptionResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTagOptionResourceAssociationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogTagOptionResourceAssociationExists("aws_servicecatalog_tag_option_resource_association.test"),
+					resource.TestCheckResourceAttrPair("aws_servicecatalog_tag_option_resource_association.test", "tag_option_id", "aws_servicecatalog_tag_option.test", "id"),
+					resource.TestCheckResourceAttrPair("aws_servicecatalog_tag_option_resource_association.test", "resource_id", "aws_servicecatalog_portfolio.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogTagOptionResourceAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found, err := testAccAWSServiceCatalogTagOptionAssociated(rs.Primary.Attributes["tag_option_id"], rs.Primary.Attributes["resource_id"])
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Service Catalog Tag Option Resource Association %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogTagOptionResourceAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_tag_option_resource_association" {
+			continue
+		}
+
+		found, err := testAccAWSServiceCatalogTagOptionAssociated(rs.Primary.Attributes["tag_option_id"], rs.Primary.Attributes["resource_id"])
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("Service Catalog Tag Option Resource Association %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogTagOptionAssociated(tagOptionId, resourceId string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	found := false
+	err := conn.ListResourcesForTagOptionPages(&servicecatalog.ListResourcesForTagOptionInput{
+		TagOptionId: aws.String(tagOptionId),
+	}, func(page *servicecatalog.ListResourcesForTagOptionOutput, lastPage bool) bool {
+		for _, detail := range page.ResourceDetails {
+			if aws.StringValue(detail.Id) == resourceId {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccAWSServiceCatalogTagOptionResourceAssociationConfig(name string) string {
+	return testAccAWSServiceCatalogPortfolioConfig_basic(name) +
+		testAccAWSServiceCatalogTagOptionConfig(name, "value", true) + `
+resource "aws_servicecatalog_tag_option_resource_association" "test" {
+  tag_option_id = "${aws_servicecatalog_tag_option.test.id}"
+  resource_id   = "${aws_servicecatalog_portfolio.test.id}"
+}
+`
+}
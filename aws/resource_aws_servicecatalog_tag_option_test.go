@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogTagOption_basic(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogTagOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTagOptionConfig(name, "value", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogTagOptionExists("aws_servicecatalog_tag_option.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_tag_option.test", "key", "tf-test-"+name),
+					resource.TestCheckResourceAttr("aws_servicecatalog_tag_option.test", "value", "value"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_tag_option.test", "active", "true"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogTagOptionConfig(name, "value-updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogTagOptionExists("aws_servicecatalog_tag_option.test"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_tag_option.test", "value", "value-updated"),
+					resource.TestCheckResourceAttr("aws_servicecatalog_tag_option.test", "active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogTagOptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Tag Option ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+		_, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+// Tag options can't be deleted, so a destroyed tag option is an inactive one.
+func testAccCheckAWSServiceCatalogTagOptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).servicecatalogconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_tag_option" {
+			continue
+		}
+
+		resp, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.BoolValue(resp.TagOptionDetail.Active) {
+			return fmt.Errorf("Service Catalog Tag Option %s is still active", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogTagOptionConfig(name, value string, active bool) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_tag_option" "test" {
+  key    = "tf-test-%s"
+  value  = "%s"
+  active = %t
+}
+`, name, value, active)
+}
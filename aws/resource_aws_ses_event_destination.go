@@ -83,13 +83,15 @@ func resourceAwsSesEventDestination() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"stream_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArnOf("firehose", "deliverystream"),
 						},
 
 						"role_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
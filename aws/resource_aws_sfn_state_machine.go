@@ -40,7 +40,7 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"creation_date": {
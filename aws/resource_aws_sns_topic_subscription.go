@@ -58,8 +58,9 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				Default:  1,
 			},
 			"topic_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"delivery_policy": {
 				Type:     schema.TypeString,
@@ -126,6 +126,15 @@ func resourceAwsSqsQueue() *schema.Resource {
 	}
 }
 
+// resourceAwsSqsQueueCustomizeDiff checks the naming and deduplication
+// settings of FIFO queues at plan time.
+func resourceAwsSqsQueueCustomizeDiff() customizeDiffFunc {
+	return validateDiff(
+		resourceAwsSqsQueueValidateName,
+		resourceAwsSqsQueueValidateContentBasedDeduplication,
+	)
+}
+
 func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	sqsconn := meta.(*AWSClient).sqsconn
 
@@ -322,3 +331,46 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 	return segments[2], nil
 
 }
+
+// resourceAwsSqsQueueValidateName checks the name of the queue against the
+// naming rules of its type, as the names of FIFO queues must end in ".fifo".
+func resourceAwsSqsQueueValidateName(d *resourceDiff) error {
+	if !d.NewValueKnown("fifo_queue") || !d.NewValueKnown("name") {
+		return nil
+	}
+
+	fq := d.Get("fifo_queue").(bool)
+	v, ok := d.GetOk("name")
+	if !ok {
+		if fq {
+			return fmt.Errorf("FIFO queues require a name ending in \".fifo\", name_prefix and generated names can't be used")
+		}
+		return nil
+	}
+	name := v.(string)
+
+	if fq {
+		if errors := validateSQSFifoQueueName(name, "name"); len(errors) > 0 {
+			return fmt.Errorf("Error validating the FIFO queue name: %v", errors)
+		}
+		return nil
+	}
+
+	if strings.HasSuffix(name, ".fifo") {
+		return fmt.Errorf("Queue name %q ends in \".fifo\", which is only allowed with fifo_queue = true", name)
+	}
+	if errors := validateSQSQueueName(name, "name"); len(errors) > 0 {
+		return fmt.Errorf("Error validating SQS queue name: %v", errors)
+	}
+	return nil
+}
+
+func resourceAwsSqsQueueValidateContentBasedDeduplication(d *resourceDiff) error {
+	if !d.NewValueKnown("fifo_queue") {
+		return nil
+	}
+	if d.Get("content_based_deduplication").(bool) && !d.Get("fifo_queue").(bool) {
+		return fmt.Errorf("Content based deduplication can only be set with FIFO queues")
+	}
+	return nil
+}
@@ -17,7 +17,7 @@ import (
 )
 
 func TestAccAWSSQSQueue_basic(t *testing.T) {
-	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(10))
+	queueName := fmt.Sprintf("sqs-queue-%s", testAccRandString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
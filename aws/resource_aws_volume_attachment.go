@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 		Create: resourceAwsVolumeAttachmentCreate,
 		Read:   resourceAwsVolumeAttachmentRead,
 		Delete: resourceAwsVolumeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVolumeAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"device_name": {
@@ -241,6 +245,24 @@ func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceAwsVolumeAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected DEVICE_NAME:VOLUME_ID:INSTANCE_ID", d.Id())
+	}
+
+	name := idParts[0]
+	vID := idParts[1]
+	iID := idParts[2]
+
+	d.Set("device_name", name)
+	d.Set("volume_id", vID)
+	d.Set("instance_id", iID)
+	d.SetId(volumeAttachmentID(name, vID, iID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func volumeAttachmentID(name, volumeID, instanceID string) string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", name))
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,9 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 		Read:   resourceAwsVpcDhcpOptionsAssociationRead,
 		Update: resourceAwsVpcDhcpOptionsAssociationUpdate,
 		Delete: resourceAwsVpcDhcpOptionsAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcDhcpOptionsAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -76,6 +80,27 @@ func resourceAwsVpcDhcpOptionsAssociationRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+// resourceAwsVpcDhcpOptionsAssociationImport imports the association of a VPC
+// by the VPC ID.
+func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return nil, err
+	}
+	if vpcRaw == nil {
+		return nil, fmt.Errorf("VPC %q not found", d.Id())
+	}
+	vpc := vpcRaw.(*ec2.Vpc)
+
+	d.Set("vpc_id", vpc.VpcId)
+	d.Set("dhcp_options_id", vpc.DhcpOptionsId)
+	d.SetId(*vpc.DhcpOptionsId + "-" + *vpc.VpcId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // DHCP Options Asociations cannot be updated.
 func resourceAwsVpcDhcpOptionsAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsVpcDhcpOptionsAssociationCreate(d, meta)
@@ -22,6 +22,12 @@ func resourceAwsVpnGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": &schema.Schema{
 				Type:     schema.TypeString,
@@ -61,7 +67,15 @@ func resourceAwsVpnGatewayCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] VPN Gateway ID: %s", *vpnGateway.VpnGatewayId)
 
 	// Attach the VPN gateway to the correct VPC
-	return resourceAwsVpnGatewayUpdate(d, meta)
+	if err := resourceAwsVpnGatewayAttach(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsVpnGatewayRead(d, meta)
 }
 
 func resourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
@@ -106,12 +120,12 @@ func resourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("vpc_id") {
 		// If we're already attached, detach it first
-		if err := resourceAwsVpnGatewayDetach(d, meta); err != nil {
+		if err := resourceAwsVpnGatewayDetach(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
 		}
 
 		// Attach the VPN gateway to the new vpc
-		if err := resourceAwsVpnGatewayAttach(d, meta); err != nil {
+		if err := resourceAwsVpnGatewayAttach(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
 		}
 	}
@@ -131,13 +145,13 @@ func resourceAwsVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error
 	conn := meta.(*AWSClient).ec2conn
 
 	// Detach if it is attached
-	if err := resourceAwsVpnGatewayDetach(d, meta); err != nil {
+	if err := resourceAwsVpnGatewayDetach(d, meta, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return err
 	}
 
 	log.Printf("[INFO] Deleting VPN gateway: %s", d.Id())
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := conn.DeleteVpnGateway(&ec2.DeleteVpnGatewayInput{
 			VpnGatewayId: aws.String(d.Id()),
 		})
@@ -161,7 +175,7 @@ func resourceAwsVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error
 	})
 }
 
-func resourceAwsVpnGatewayAttach(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsVpnGatewayAttach(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	if d.Get("vpc_id").(string) == "" {
@@ -205,7 +219,7 @@ func resourceAwsVpnGatewayAttach(d *schema.ResourceData, meta interface{}) error
 		Pending: []string{"detached", "attaching"},
 		Target:  []string{"attached"},
 		Refresh: vpnGatewayAttachStateRefreshFunc(conn, d.Id(), "available"),
-		Timeout: 10 * time.Minute,
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf(
@@ -216,7 +230,7 @@ func resourceAwsVpnGatewayAttach(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAwsVpnGatewayDetach(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsVpnGatewayDetach(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	// Get the old VPC ID to detach from
@@ -266,7 +280,7 @@ func resourceAwsVpnGatewayDetach(d *schema.ResourceData, meta interface{}) error
 		Pending: []string{"attached", "detaching", "available"},
 		Target:  []string{"detached"},
 		Refresh: vpnGatewayAttachStateRefreshFunc(conn, d.Id(), "detached"),
-		Timeout: 10 * time.Minute,
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf(
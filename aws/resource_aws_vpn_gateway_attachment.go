@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsVpnGatewayAttachment() *schema.Resource {
 		Create: resourceAwsVpnGatewayAttachmentCreate,
 		Read:   resourceAwsVpnGatewayAttachmentRead,
 		Delete: resourceAwsVpnGatewayAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnGatewayAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -167,6 +171,22 @@ func resourceAwsVpnGatewayAttachmentDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func resourceAwsVpnGatewayAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPCID/VPNGATEWAYID", d.Id())
+	}
+
+	vpcId := idParts[0]
+	vgwId := idParts[1]
+
+	d.Set("vpc_id", vpcId)
+	d.Set("vpn_gateway_id", vgwId)
+	d.SetId(vpnGatewayAttachmentId(vpcId, vgwId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func vpnGatewayAttachmentStateRefresh(conn *ec2.EC2, vpcId, vgwId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := conn.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// customizeDiffFunc checks the planned change of a resource, and vetoes it by
+// returning an error. It's passed a *resourceDiff and the provider's meta,
+// which is nil if the provider isn't configured yet.
+type customizeDiffFunc func(*resourceDiff, interface{}) error
+
+// awsProvider is the provider's schema.Provider, with plan-time checks of the
+// changes to resources. The vendored helper/schema predates the CustomizeDiff
+// function of resources, so the checks are run by Diff instead. Diff also
+// plans the provider's default_tags along with the configured tags.
+type awsProvider struct {
+	*schema.Provider
+
+	// customizeDiff holds the plan-time checks by resource type.
+	customizeDiff map[string]customizeDiffFunc
+}
+
+// Diff computes the diff of a resource like schema.Provider, with the default
+// tags merged into its configuration, then runs the checks of the resource
+// against it. Like CustomizeDiff, the checks run once
+// against the existing resource and, if it must be replaced, once more as if
+// it were a new resource.
+func (p *awsProvider) Diff(
+	info *terraform.InstanceInfo,
+	s *terraform.InstanceState,
+	c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	if r, ok := p.ResourcesMap[info.Type]; ok {
+		c = providerTagsConfig(r, c, p.Meta())
+	}
+
+	diff, err := p.Provider.Diff(info, s, c)
+	if err != nil || diff == nil || diff.DestroyTainted {
+		return diff, err
+	}
+
+	customizeDiff, ok := p.customizeDiff[info.Type]
+	if !ok {
+		return diff, nil
+	}
+	r := p.ResourcesMap[info.Type]
+
+	if err := customizeDiff(newResourceDiff(r, s, c, diff), p.Meta()); err != nil {
+		return nil, err
+	}
+	if s != nil && s.ID != "" && diff.RequiresNew() {
+		if err := customizeDiff(newResourceDiff(r, nil, c, diff), p.Meta()); err != nil {
+			return nil, err
+		}
+	}
+
+	return diff, nil
+}
+
+// addCustomizeDiff adds a check to those of a resource type, which run in the
+// order they were added.
+func (p *awsProvider) addCustomizeDiff(name string, f customizeDiffFunc) {
+	prev, ok := p.customizeDiff[name]
+	if !ok {
+		p.customizeDiff[name] = f
+		return
+	}
+	p.customizeDiff[name] = func(d *resourceDiff, meta interface{}) error {
+		if err := prev(d, meta); err != nil {
+			return err
+		}
+		return f(d, meta)
+	}
+}
+
+// resourceDiff is a read-only view of the planned change of a resource. It
+// mirrors the ResourceDiff of later versions of helper/schema.
+type resourceDiff struct {
+	config *terraform.ResourceConfig
+	state  *terraform.InstanceState
+	diff   *terraform.InstanceDiff
+
+	// old reads the current state of the resource, new the planned one.
+	old *schema.ResourceData
+	new *schema.ResourceData
+}
+
+func newResourceDiff(r *schema.Resource, s *terraform.InstanceState, c *terraform.ResourceConfig, diff *terraform.InstanceDiff) *resourceDiff {
+	// A replaced resource is planned from scratch
+	base := s
+	if diff.RequiresNew() {
+		base = nil
+	}
+
+	planned := &terraform.InstanceState{Attributes: make(map[string]string)}
+	if base != nil {
+		for k, v := range base.Attributes {
+			planned.Attributes[k] = v
+		}
+	}
+	for k, attr := range diff.CopyAttributes() {
+		// Values that aren't known until apply read as zero values
+		if attr.NewRemoved || attr.NewComputed {
+			delete(planned.Attributes, k)
+			continue
+		}
+		planned.Attributes[k] = attr.New
+	}
+
+	return &resourceDiff{
+		config: c,
+		state:  s,
+		diff:   diff,
+		old:    r.Data(s),
+		new:    r.Data(planned),
+	}
+}
+
+// Get returns the planned value of a key.
+func (d *resourceDiff) Get(key string) interface{} {
+	return d.new.Get(key)
+}
+
+// GetOk returns the planned value of a key, and whether it's set to a
+// non-zero value.
+func (d *resourceDiff) GetOk(key string) (interface{}, bool) {
+	return d.new.GetOk(key)
+}
+
+// GetChange returns the current and the planned value of a key.
+func (d *resourceDiff) GetChange(key string) (interface{}, interface{}) {
+	return d.old.Get(key), d.new.Get(key)
+}
+
+// HasChange reports whether the value of a key changes.
+func (d *resourceDiff) HasChange(key string) bool {
+	o, n := d.GetChange(key)
+	if eq, ok := o.(interface {
+		Equal(interface{}) bool
+	}); ok {
+		return !eq.Equal(n)
+	}
+	return !reflect.DeepEqual(o, n)
+}
+
+// Id returns the ID of the resource, which is empty for new resources.
+func (d *resourceDiff) Id() string {
+	if d.state == nil {
+		return ""
+	}
+	return d.state.ID
+}
+
+// NewValueKnown reports whether the configured value of a key is known at
+// plan time. It isn't if it's interpolated from attributes that are only known
+// after apply.
+func (d *resourceDiff) NewValueKnown(key string) bool {
+	if d.config == nil {
+		return true
+	}
+	return !d.config.IsComputed(key)
+}
+
+// RequiresNew reports whether the change replaces the resource.
+func (d *resourceDiff) RequiresNew() bool {
+	return d.diff.RequiresNew()
+}
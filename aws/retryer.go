@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+const (
+	// Backoff of retried requests, see awsRetryer.RetryRules.
+	awsRetryBaseDelay         = 100 * time.Millisecond
+	awsRetryThrottleBaseDelay = 500 * time.Millisecond
+	awsRetryMaxDelay          = 30 * time.Second
+
+	// Request rates of the shared token bucket in requests per second, see
+	// awsRetryTokenBucket.
+	awsRetryTokenBucketInitialRate = 10.0
+	awsRetryTokenBucketMinRate     = 0.5
+	awsRetryTokenBucketMaxRate     = 50.0
+	awsRetryTokenBucketRateStep    = 0.5
+)
+
+// awsRetryRule is an additional condition under which requests of a service
+// are retried, on top of the error codes and HTTP status codes the SDK
+// already treats as retryable.
+type awsRetryRule struct {
+	// OperationPrefixes limits the rule to the operations whose names start
+	// with one of these prefixes. An empty list matches every operation.
+	OperationPrefixes []string
+
+	// Codes are the error codes the rule applies to.
+	Codes []string
+
+	// Throttle marks the error codes as throttling errors, which are backed
+	// off for longer and slow down the requests of all service clients.
+	Throttle bool
+}
+
+func (rule awsRetryRule) matches(r *request.Request) bool {
+	err, ok := r.Error.(awserr.Error)
+	if !ok || err == nil {
+		return false
+	}
+
+	if len(rule.OperationPrefixes) > 0 {
+		matched := false
+		for _, prefix := range rule.OperationPrefixes {
+			if strings.HasPrefix(r.Operation.Name, prefix) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	for _, code := range rule.Codes {
+		if err.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+var awsReadOperationPrefixes = []string{"Describe", "List"}
+
+// awsServiceRetryRules holds the additional retry rules of the service
+// clients, keyed by the name of the client in awsServiceClients.
+var awsServiceRetryRules = map[string][]awsRetryRule{
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	"applicationautoscaling": {
+		{
+			OperationPrefixes: awsReadOperationPrefixes,
+			Codes:             []string{applicationautoscaling.ErrCodeFailedResourceAccessException},
+		},
+	},
+	"elb": {
+		{Codes: []string{elb.ErrCodeDependencyThrottleException}, Throttle: true},
+	},
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
+	"kinesis": {
+		{
+			OperationPrefixes: awsReadOperationPrefixes,
+			Codes:             []string{kinesis.ErrCodeLimitExceededException},
+			Throttle:          true,
+		},
+		{Codes: []string{kinesis.ErrCodeKMSThrottlingException}, Throttle: true},
+	},
+	"redshift": {
+		{Codes: []string{redshift.ErrCodeDependentServiceRequestThrottlingFault}, Throttle: true},
+	},
+	"route53": {
+		{Codes: []string{route53.ErrCodeThrottlingException}, Throttle: true},
+	},
+	"sns": {
+		{Codes: []string{sns.ErrCodeThrottledException}, Throttle: true},
+	},
+}
+
+// awsRetryer is the request.Retryer of every service client. On top of the
+// SDK's DefaultRetryer it retries the errors matched by the retry rules of
+// its service, backs off exponentially with jitter and reports throttling to
+// the token bucket shared by all service clients.
+type awsRetryer struct {
+	client.DefaultRetryer
+
+	rules  []awsRetryRule
+	bucket *awsRetryTokenBucket
+}
+
+func newAwsRetryer(maxRetries int, rules []awsRetryRule, bucket *awsRetryTokenBucket) awsRetryer {
+	return awsRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+		rules:          rules,
+		bucket:         bucket,
+	}
+}
+
+// ShouldRetry returns true if the request should be retried.
+func (d awsRetryer) ShouldRetry(r *request.Request) bool {
+	if r.Retryable != nil {
+		return *r.Retryable
+	}
+
+	if d.isThrottle(r) {
+		if d.bucket != nil {
+			d.bucket.throttled()
+		}
+		return true
+	}
+
+	for _, rule := range d.rules {
+		if rule.matches(r) {
+			return true
+		}
+	}
+
+	return d.DefaultRetryer.ShouldRetry(r)
+}
+
+// RetryRules returns the delay before retrying the request. The delay doubles
+// with every retry up to awsRetryMaxDelay, and a random half of it is
+// jittered so that requests failing together don't retry in lockstep.
+func (d awsRetryer) RetryRules(r *request.Request) time.Duration {
+	delay := awsRetryBaseDelay
+	if d.isThrottle(r) {
+		delay = awsRetryThrottleBaseDelay
+	}
+
+	for i := 0; i < r.RetryCount && delay < awsRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > awsRetryMaxDelay {
+		delay = awsRetryMaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+func (d awsRetryer) isThrottle(r *request.Request) bool {
+	if r.IsErrorThrottle() {
+		return true
+	}
+
+	if r.HTTPResponse != nil {
+		switch r.HTTPResponse.StatusCode {
+		case 429, 502, 503, 504:
+			return true
+		}
+	}
+
+	for _, rule := range d.rules {
+		if rule.Throttle && rule.matches(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// awsRetryTokenBucket limits the rate at which the service clients send
+// requests once any of them got throttled. Each request attempt takes a token,
+// and tokens are refilled at the current rate. A throttled request halves the
+// rate (at most once per second), while successful requests raise it again by
+// awsRetryTokenBucketRateStep per second until it reaches
+// awsRetryTokenBucketMaxRate, at which point the bucket stops limiting
+// altogether.
+type awsRetryTokenBucket struct {
+	sync.Mutex
+
+	// rate is the number of requests per second, or 0 while unlimited.
+	rate          float64
+	tokens        float64
+	last          time.Time
+	lastThrottled time.Time
+
+	now func() time.Time
+}
+
+func newAwsRetryTokenBucket() *awsRetryTokenBucket {
+	return &awsRetryTokenBucket{now: time.Now}
+}
+
+// reserve takes a token and returns how long to wait before it is available.
+func (b *awsRetryTokenBucket) reserve() time.Duration {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.rate == 0 {
+		return 0
+	}
+
+	now := b.now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *awsRetryTokenBucket) throttled() {
+	b.Lock()
+	defer b.Unlock()
+
+	now := b.now()
+	if b.rate == 0 {
+		b.rate = awsRetryTokenBucketInitialRate
+		b.tokens = 0
+		b.last = now
+		b.lastThrottled = now
+		return
+	}
+
+	if now.Sub(b.lastThrottled) < time.Second {
+		return
+	}
+	b.lastThrottled = now
+
+	b.rate /= 2
+	if b.rate < awsRetryTokenBucketMinRate {
+		b.rate = awsRetryTokenBucketMinRate
+	}
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+func (b *awsRetryTokenBucket) succeeded() {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.rate == 0 {
+		return
+	}
+
+	b.rate += awsRetryTokenBucketRateStep / b.rate
+	if b.rate >= awsRetryTokenBucketMaxRate {
+		b.rate = 0
+		b.tokens = 0
+	}
+}
+
+// handlers installs the bucket on the handlers of a session, so that it
+// applies to every service client created from it.
+func (b *awsRetryTokenBucket) handlers(handlers *request.Handlers) {
+	// Every attempt of a request is signed right before it is sent, so
+	// waiting in the first Sign handler delays each of them.
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform.RetryTokenBucketSignHandler",
+		Fn: func(r *request.Request) {
+			delay := b.reserve()
+			if delay == 0 {
+				return
+			}
+			if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.RetryTokenBucketCompleteHandler",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				b.succeeded()
+			}
+		},
+	})
+}
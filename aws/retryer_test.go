@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func testAwsRetryerRequest(operation, code string, statusCode int) *request.Request {
+	return &request.Request{
+		Operation:    &request.Operation{Name: operation},
+		Error:        awserr.New(code, "test error", nil),
+		HTTPResponse: &http.Response{StatusCode: statusCode},
+	}
+}
+
+func TestAwsServiceRetryRules(t *testing.T) {
+	clients := make(map[string]bool)
+	for _, svc := range awsServiceClients {
+		clients[svc.endpoint] = true
+	}
+
+	for name := range awsServiceRetryRules {
+		if !clients[name] {
+			t.Errorf("Retry rules for unknown service client %q", name)
+		}
+	}
+}
+
+func TestAwsRetryerShouldRetry(t *testing.T) {
+	cases := []struct {
+		Service    string
+		Operation  string
+		Code       string
+		StatusCode int
+		Retry      bool
+		Throttle   bool
+	}{
+		{"ec2", "DescribeInstances", "RequestLimitExceeded", 503, true, true},
+		{"ec2", "DescribeInstances", "InvalidInstanceID.NotFound", 400, false, false},
+		{"ec2", "DescribeInstances", "InternalError", 500, true, false},
+		{"iam", "GetRole", "Throttling", 400, true, true},
+		{"kinesis", "DescribeStream", "LimitExceededException", 400, true, true},
+		{"kinesis", "CreateStream", "LimitExceededException", 400, false, false},
+		{"applicationautoscaling", "DescribeScalableTargets", "FailedResourceAccessException", 400, true, false},
+		{"applicationautoscaling", "RegisterScalableTarget", "FailedResourceAccessException", 400, false, false},
+		{"sns", "Publish", "Throttled", 400, true, true},
+		{"sqs", "Publish", "Throttled", 400, false, false},
+	}
+
+	for _, tc := range cases {
+		bucket := newAwsRetryTokenBucket()
+		retryer := newAwsRetryer(25, awsServiceRetryRules[tc.Service], bucket)
+		r := testAwsRetryerRequest(tc.Operation, tc.Code, tc.StatusCode)
+
+		if retry := retryer.ShouldRetry(r); retry != tc.Retry {
+			t.Errorf("%s %s %s: expected retry %t, got %t", tc.Service, tc.Operation, tc.Code, tc.Retry, retry)
+		}
+		if throttled := bucket.rate != 0; throttled != tc.Throttle {
+			t.Errorf("%s %s %s: expected throttle %t, got %t", tc.Service, tc.Operation, tc.Code, tc.Throttle, throttled)
+		}
+	}
+}
+
+func TestAwsRetryerRetryRules(t *testing.T) {
+	retryer := newAwsRetryer(25, nil, nil)
+
+	for retryCount := 0; retryCount < 25; retryCount++ {
+		for _, code := range []string{"InternalError", "Throttling"} {
+			r := testAwsRetryerRequest("DescribeInstances", code, 500)
+			r.RetryCount = retryCount
+
+			max := awsRetryBaseDelay
+			if code == "Throttling" {
+				max = awsRetryThrottleBaseDelay
+			}
+			for i := 0; i < retryCount && max < awsRetryMaxDelay; i++ {
+				max *= 2
+			}
+			if max > awsRetryMaxDelay {
+				max = awsRetryMaxDelay
+			}
+
+			delay := retryer.RetryRules(r)
+			if delay < max/2 || delay > max {
+				t.Errorf("%s retry %d: expected delay between %s and %s, got %s", code, retryCount, max/2, max, delay)
+			}
+		}
+	}
+}
+
+func TestAwsRetryTokenBucket(t *testing.T) {
+	now := time.Date(2017, 9, 1, 0, 0, 0, 0, time.UTC)
+	bucket := newAwsRetryTokenBucket()
+	bucket.now = func() time.Time { return now }
+
+	for i := 0; i < 100; i++ {
+		if delay := bucket.reserve(); delay != 0 {
+			t.Fatalf("Expected no delay before throttling, got %s", delay)
+		}
+	}
+
+	bucket.throttled()
+	if bucket.rate != awsRetryTokenBucketInitialRate {
+		t.Fatalf("Expected rate %f after throttling, got %f", awsRetryTokenBucketInitialRate, bucket.rate)
+	}
+
+	// Requests queue up behind each other at the current rate
+	for i := 1; i <= 5; i++ {
+		expected := time.Duration(float64(i) / awsRetryTokenBucketInitialRate * float64(time.Second))
+		if delay := bucket.reserve(); delay != expected {
+			t.Fatalf("Expected delay %s for request %d, got %s", expected, i, delay)
+		}
+	}
+
+	// Throttling within a second of the last one doesn't lower the rate
+	// any further
+	bucket.throttled()
+	if bucket.rate != awsRetryTokenBucketInitialRate {
+		t.Fatalf("Expected rate %f, got %f", awsRetryTokenBucketInitialRate, bucket.rate)
+	}
+
+	now = now.Add(2 * time.Second)
+	bucket.throttled()
+	if bucket.rate != awsRetryTokenBucketInitialRate/2 {
+		t.Fatalf("Expected rate %f, got %f", awsRetryTokenBucketInitialRate/2, bucket.rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		now = now.Add(2 * time.Second)
+		bucket.throttled()
+	}
+	if bucket.rate != awsRetryTokenBucketMinRate {
+		t.Fatalf("Expected rate %f, got %f", awsRetryTokenBucketMinRate, bucket.rate)
+	}
+
+	// Successful requests recover the rate until the bucket stops limiting
+	for i := 0; i < 100000 && bucket.rate != 0; i++ {
+		bucket.succeeded()
+	}
+	if bucket.rate != 0 {
+		t.Fatalf("Expected bucket to stop limiting, rate is %f", bucket.rate)
+	}
+	if delay := bucket.reserve(); delay != 0 {
+		t.Fatalf("Expected no delay after recovering, got %s", delay)
+	}
+}
@@ -11,32 +11,37 @@ import (
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
+// tags field to be named "tags". S3 only allows the tag set of a bucket to be
+// replaced as a whole, so the live tags are read first and any tag matching
+// ignoreKeys or ignorePrefixes, or reserved by AWS, is carried over to the new
+// set rather than being deleted.
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, ignoreKeys, ignorePrefixes []string) error {
+	if _, n, ok := tagsChange(d); ok {
+		bucket := d.Get("bucket").(string)
+		live, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return getTagSetS3(conn, bucket)
+		})
+		if err != nil {
+			return err
+		}
+		tagSet := mergeIgnoredTagsS3(tagsFromMapS3(n), live.([]*s3.Tag), ignoreKeys, ignorePrefixes)
+
+		if len(tagSet) == 0 {
+			log.Printf("[DEBUG] Removing all tags of %s", bucket)
 			_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
 				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
-					Bucket: aws.String(d.Get("bucket").(string)),
+					Bucket: aws.String(bucket),
 				})
 			})
 			if err != nil {
 				return err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+		} else {
+			log.Printf("[DEBUG] Setting tags: %#v", tagSet)
 			req := &s3.PutBucketTaggingInput{
-				Bucket: aws.String(d.Get("bucket").(string)),
+				Bucket: aws.String(bucket),
 				Tagging: &s3.Tagging{
-					TagSet: create,
+					TagSet: tagSet,
 				},
 			}
 
@@ -52,6 +57,28 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
+// mergeIgnoredTagsS3 returns tags plus every tag of live that Terraform doesn't
+// manage: those reserved by AWS and those matching ignore_tags.
+func mergeIgnoredTagsS3(tags, live []*s3.Tag, ignoreKeys, ignorePrefixes []string) []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags)+len(live))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		seen[*t.Key] = true
+		result = append(result, t)
+	}
+	for _, t := range live {
+		if seen[*t.Key] {
+			continue
+		}
+		if tagIgnoredS3(t) || tagKeyIgnored(*t.Key, ignoreKeys, ignorePrefixes) {
+			log.Printf("[DEBUG] Keeping unmanaged tag %s", *t.Key)
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
@@ -75,3 +75,37 @@ func TestIgnoringTagsS3(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeIgnoredTagsS3(t *testing.T) {
+	tags := tagsFromMapS3(map[string]interface{}{
+		"Name":  "foo",
+		"owner": "terraform",
+	})
+	live := tagsFromMapS3(map[string]interface{}{
+		"Name":                       "old",
+		"owner":                      "someone-else",
+		"removed":                    "bar",
+		"scanner-last-run":           "2017-08-01",
+		"kubernetes.io/cluster/test": "owned",
+	})
+	live = append(live, &s3.Tag{
+		Key:   aws.String("aws:cloudformation:stack-name"),
+		Value: aws.String("stack"),
+	})
+	expected := map[string]string{
+		"Name":                          "foo",
+		"owner":                         "terraform",
+		"scanner-last-run":              "2017-08-01",
+		"kubernetes.io/cluster/test":    "owned",
+		"aws:cloudformation:stack-name": "stack",
+	}
+
+	merged := mergeIgnoredTagsS3(tags, live, []string{"scanner-last-run"}, []string{"kubernetes.io/"})
+	m := make(map[string]string, len(merged))
+	for _, t := range merged {
+		m[*t.Key] = *t.Value
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Fatalf("bad result: %#v", m)
+	}
+}
@@ -0,0 +1,503 @@
+package aws
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/go-ini/ini"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	credentialProcessProviderName = "CredentialProcessProvider"
+	ssoProviderName               = "SSOProvider"
+)
+
+// sharedConfigProfile is a profile of the shared config file, merged with the
+// profile of the same name in the shared credentials file.
+type sharedConfigProfile struct {
+	Name string
+
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	RoleARN          string
+	SourceProfile    string
+	CredentialSource string
+	ExternalID       string
+	RoleSessionName  string
+	MFASerial        string
+	DurationSeconds  int
+
+	CredentialProcess string
+
+	SSOStartURL  string
+	SSORegion    string
+	SSOAccountID string
+	SSORoleName  string
+}
+
+// usesUnsupportedSource returns whether the profile needs more than the
+// SharedCredentialsProvider of the SDK supports.
+func (p *sharedConfigProfile) usesUnsupportedSource() bool {
+	return p.RoleARN != "" || p.CredentialProcess != "" || p.SSOStartURL != ""
+}
+
+// loadSharedConfigProfiles reads the profiles of the shared config and
+// credentials files. Files that don't exist are ignored.
+func loadSharedConfigProfiles(configFilename, credsFilename string) (map[string]*sharedConfigProfile, error) {
+	profiles := make(map[string]*sharedConfigProfile)
+
+	// Settings in the credentials file take precedence, so it is read last
+	for _, file := range []struct {
+		Filename string
+		IsConfig bool
+	}{
+		{configFilename, true},
+		{credsFilename, false},
+	} {
+		if _, err := os.Stat(file.Filename); os.IsNotExist(err) {
+			continue
+		}
+
+		f, err := ini.Load(file.Filename)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading shared config file %q: %s", file.Filename, err)
+		}
+
+		for _, section := range f.Sections() {
+			name := strings.TrimSpace(section.Name())
+			if name == ini.DEFAULT_SECTION && len(section.Keys()) == 0 {
+				continue
+			}
+			// Profiles of the config file are named "profile NAME", except
+			// for the default profile
+			if file.IsConfig && strings.HasPrefix(name, "profile ") {
+				name = strings.TrimSpace(strings.TrimPrefix(name, "profile "))
+			}
+
+			profile, ok := profiles[name]
+			if !ok {
+				profile = &sharedConfigProfile{Name: name}
+				profiles[name] = profile
+			}
+
+			for key, value := range map[string]*string{
+				"aws_access_key_id":     &profile.AccessKeyID,
+				"aws_secret_access_key": &profile.SecretAccessKey,
+				"aws_session_token":     &profile.SessionToken,
+				"role_arn":              &profile.RoleARN,
+				"source_profile":        &profile.SourceProfile,
+				"credential_source":     &profile.CredentialSource,
+				"external_id":           &profile.ExternalID,
+				"role_session_name":     &profile.RoleSessionName,
+				"mfa_serial":            &profile.MFASerial,
+				"credential_process":    &profile.CredentialProcess,
+				"sso_start_url":         &profile.SSOStartURL,
+				"sso_region":            &profile.SSORegion,
+				"sso_account_id":        &profile.SSOAccountID,
+				"sso_role_name":         &profile.SSORoleName,
+			} {
+				if section.HasKey(key) {
+					*value = strings.TrimSpace(section.Key(key).String())
+				}
+			}
+
+			if section.HasKey("duration_seconds") {
+				v, err := section.Key("duration_seconds").Int()
+				if err != nil {
+					return nil, fmt.Errorf("Error reading duration_seconds of profile %q in %q: %s", name, file.Filename, err)
+				}
+				profile.DurationSeconds = v
+			}
+		}
+	}
+
+	return profiles, nil
+}
+
+// getSharedConfigProvider returns a credentials provider for the profile of
+// the configuration, if it assumes a role, runs a credential_process or uses
+// AWS SSO. It returns nil for any other profile, which is then handled by the
+// SharedCredentialsProvider of the SDK. Profiles that can't be read or that use
+// settings we don't support are skipped with a warning rather than failing, as
+// the SDK ignored them before.
+func getSharedConfigProvider(c *Config) awsCredentials.Provider {
+	profile := c.Profile
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	configFilename, err := sharedConfigFilename(c.SharedConfigFilename, "AWS_CONFIG_FILE", "config")
+	if err != nil {
+		log.Printf("[WARN] Ignoring shared config file: %s", err)
+		return nil
+	}
+	credsFilename, err := sharedConfigFilename(c.CredsFilename, "AWS_SHARED_CREDENTIALS_FILE", "credentials")
+	if err != nil {
+		log.Printf("[WARN] Ignoring shared config file: %s", err)
+		return nil
+	}
+
+	profiles, err := loadSharedConfigProfiles(configFilename, credsFilename)
+	if err != nil {
+		log.Printf("[WARN] Ignoring shared config file: %s", err)
+		return nil
+	}
+
+	if p, ok := profiles[profile]; !ok || !p.usesUnsupportedSource() {
+		return nil
+	}
+
+	provider, err := sharedConfigProfileProvider(c, profiles, profile, make(map[string]bool))
+	if err != nil {
+		log.Printf("[WARN] Ignoring profile %q of shared config file %q: %s", profile, configFilename, err)
+		return nil
+	}
+
+	log.Printf("[INFO] Using profile %q of shared config file %q", profile, configFilename)
+	return provider
+}
+
+func sharedConfigFilename(filename, envVar, name string) (string, error) {
+	if filename == "" {
+		filename = os.Getenv(envVar)
+	}
+	if filename == "" {
+		filename = filepath.Join("~", ".aws", name)
+	}
+	return homedir.Expand(filename)
+}
+
+// sharedConfigProfileProvider returns the credentials provider of a profile,
+// following its source_profile chain.
+func sharedConfigProfileProvider(c *Config, profiles map[string]*sharedConfigProfile, name string, visited map[string]bool) (awsCredentials.Provider, error) {
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("Profile %q not found in shared config files", name)
+	}
+	if visited[name] {
+		return nil, fmt.Errorf("Profile %q is part of a source_profile loop", name)
+	}
+	visited[name] = true
+
+	switch {
+	case profile.RoleARN != "":
+		if profile.MFASerial != "" {
+			return nil, fmt.Errorf("Profile %q requires MFA (mfa_serial), which is not supported in profiles. "+
+				"Use an assume_role block with token_code_command in the provider configuration instead.", name)
+		}
+
+		var source awsCredentials.Provider
+		switch {
+		case profile.SourceProfile == name:
+			// A profile can be its own source, using its static credentials
+			source = sharedConfigStaticProvider(profile)
+		case profile.SourceProfile != "":
+			var err error
+			source, err = sharedConfigProfileProvider(c, profiles, profile.SourceProfile, visited)
+			if err != nil {
+				return nil, err
+			}
+		case profile.CredentialSource != "":
+			var err error
+			source, err = sharedConfigCredentialSourceProvider(c, profile.CredentialSource)
+			if err != nil {
+				return nil, fmt.Errorf("Profile %q: %s", name, err)
+			}
+		default:
+			return nil, fmt.Errorf("Profile %q sets role_arn, but neither source_profile nor credential_source", name)
+		}
+
+		provider := &stscreds.AssumeRoleProvider{
+			Client:          sts.New(session.New(stsConfig(c, awsCredentials.NewCredentials(source)))),
+			RoleARN:         profile.RoleARN,
+			RoleSessionName: profile.RoleSessionName,
+			Duration:        stscreds.DefaultDuration,
+		}
+		if profile.ExternalID != "" {
+			provider.ExternalID = aws.String(profile.ExternalID)
+		}
+		if profile.DurationSeconds > 0 {
+			provider.Duration = time.Duration(profile.DurationSeconds) * time.Second
+		}
+		return provider, nil
+
+	case profile.CredentialProcess != "":
+		return &credentialProcessProvider{Command: profile.CredentialProcess}, nil
+
+	case profile.SSOStartURL != "":
+		cacheDir, err := homedir.Expand(filepath.Join("~", ".aws", "sso", "cache"))
+		if err != nil {
+			return nil, err
+		}
+		return &ssoProvider{
+			StartURL:  profile.SSOStartURL,
+			Region:    profile.SSORegion,
+			AccountID: profile.SSOAccountID,
+			RoleName:  profile.SSORoleName,
+			CacheDir:  cacheDir,
+
+			HTTPClient: c.httpClient(),
+		}, nil
+
+	case profile.AccessKeyID != "":
+		return sharedConfigStaticProvider(profile), nil
+	}
+
+	return nil, fmt.Errorf("Profile %q has no credentials", name)
+}
+
+// sharedConfigCredentialSourceProvider returns the provider of the source
+// credentials named by the credential_source setting of a profile.
+func sharedConfigCredentialSourceProvider(c *Config, credentialSource string) (awsCredentials.Provider, error) {
+	cfg := &aws.Config{
+		HTTPClient: c.httpClient(),
+	}
+	setOptionalEndpoint(cfg)
+
+	switch credentialSource {
+	case "Ec2InstanceMetadata":
+		return &ec2rolecreds.EC2RoleProvider{
+			Client: ec2metadata.New(session.New(cfg)),
+		}, nil
+	case "EcsContainer":
+		return defaults.RemoteCredProvider(*cfg, defaults.Handlers()), nil
+	}
+
+	return nil, fmt.Errorf("unsupported credential_source %q", credentialSource)
+}
+
+func sharedConfigStaticProvider(profile *sharedConfigProfile) awsCredentials.Provider {
+	return &awsCredentials.StaticProvider{Value: awsCredentials.Value{
+		AccessKeyID:     profile.AccessKeyID,
+		SecretAccessKey: profile.SecretAccessKey,
+		SessionToken:    profile.SessionToken,
+	}}
+}
+
+// credentialProcessOutput is the output of a credential_process, see
+// https://docs.aws.amazon.com/cli/latest/topic/config-vars.html#sourcing-credentials-from-external-processes
+type credentialProcessOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+// credentialProcessCache holds the output of credential processes until the
+// credentials expire, so that provider instances using the same profile run
+// the process only once.
+var credentialProcessCache = struct {
+	sync.Mutex
+	outputs map[string]*credentialProcessOutput
+}{outputs: make(map[string]*credentialProcessOutput)}
+
+// credentialProcessProvider retrieves credentials from the output of a
+// credential_process.
+type credentialProcessProvider struct {
+	awsCredentials.Expiry
+
+	Command string
+}
+
+func (p *credentialProcessProvider) Retrieve() (awsCredentials.Value, error) {
+	credentialProcessCache.Lock()
+	defer credentialProcessCache.Unlock()
+
+	output, ok := credentialProcessCache.outputs[p.Command]
+	if !ok || (output.Expiration != nil && time.Now().Add(time.Minute).After(*output.Expiration)) {
+		out, err := runCredentialsCommand(p.Command)
+		if err != nil {
+			log.Printf("[WARN] Error running credential_process: %s", err)
+			return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+				fmt.Errorf("Error running credential_process: %s", err)
+		}
+
+		output = &credentialProcessOutput{}
+		if err := json.Unmarshal(out, output); err != nil {
+			return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+				fmt.Errorf("Error parsing output of credential_process %q: %s", p.Command, err)
+		}
+		if output.Version != 1 {
+			return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+				fmt.Errorf("Unsupported version %d of credential_process %q output, expected 1", output.Version, p.Command)
+		}
+		if output.AccessKeyId == "" || output.SecretAccessKey == "" {
+			return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+				fmt.Errorf("Output of credential_process %q is missing AccessKeyId or SecretAccessKey", p.Command)
+		}
+
+		credentialProcessCache.outputs[p.Command] = output
+	}
+
+	if output.Expiration != nil {
+		p.SetExpiration(*output.Expiration, time.Minute)
+	}
+
+	return awsCredentials.Value{
+		AccessKeyID:     output.AccessKeyId,
+		SecretAccessKey: output.SecretAccessKey,
+		SessionToken:    output.SessionToken,
+		ProviderName:    credentialProcessProviderName,
+	}, nil
+}
+
+// IsExpired returns whether the credentials need to be retrieved again.
+// Credentials without an expiration never expire.
+func (p *credentialProcessProvider) IsExpired() bool {
+	credentialProcessCache.Lock()
+	output, ok := credentialProcessCache.outputs[p.Command]
+	credentialProcessCache.Unlock()
+
+	if !ok {
+		return true
+	}
+	if output.Expiration == nil {
+		return false
+	}
+	return p.Expiry.IsExpired()
+}
+
+// ssoProvider retrieves role credentials from the AWS SSO portal, using the
+// access token cached by `aws sso login`.
+type ssoProvider struct {
+	awsCredentials.Expiry
+
+	StartURL  string
+	Region    string
+	AccountID string
+	RoleName  string
+	CacheDir  string
+
+	// Endpoint overrides the URL of the AWS SSO portal.
+	Endpoint string
+
+	// HTTPClient is used to call the portal. If nil, a default client is used.
+	HTTPClient *http.Client
+}
+
+func (p *ssoProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := p.accessToken()
+	if err != nil {
+		return awsCredentials.Value{ProviderName: ssoProviderName}, err
+	}
+
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://portal.sso.%s.amazonaws.com", p.Region)
+	}
+	u := fmt.Sprintf("%s/federation/credentials?%s", endpoint, url.Values{
+		"account_id": []string{p.AccountID},
+		"role_name":  []string{p.RoleName},
+	}.Encode())
+
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: ssoProviderName}, err
+	}
+	req.Header.Set("x-amz-sso_bearer_token", token)
+
+	client := p.HTTPClient
+	if client == nil {
+		client = cleanhttp.DefaultClient()
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: ssoProviderName},
+			fmt.Errorf("Error getting AWS SSO role credentials: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: ssoProviderName},
+			fmt.Errorf("Error getting AWS SSO role credentials: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return awsCredentials.Value{ProviderName: ssoProviderName},
+			fmt.Errorf("Error getting AWS SSO role credentials: %s: %s", resp.Status, body)
+	}
+
+	var out struct {
+		RoleCredentials struct {
+			AccessKeyId     string `json:"accessKeyId"`
+			SecretAccessKey string `json:"secretAccessKey"`
+			SessionToken    string `json:"sessionToken"`
+			Expiration      int64  `json:"expiration"`
+		} `json:"roleCredentials"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return awsCredentials.Value{ProviderName: ssoProviderName},
+			fmt.Errorf("Error parsing AWS SSO role credentials: %s", err)
+	}
+
+	// The expiration is in milliseconds since the epoch
+	p.SetExpiration(time.Unix(0, out.RoleCredentials.Expiration*int64(time.Millisecond)), time.Minute)
+
+	return awsCredentials.Value{
+		AccessKeyID:     out.RoleCredentials.AccessKeyId,
+		SecretAccessKey: out.RoleCredentials.SecretAccessKey,
+		SessionToken:    out.RoleCredentials.SessionToken,
+		ProviderName:    ssoProviderName,
+	}, nil
+}
+
+// accessToken returns the access token that `aws sso login` cached for the
+// start URL of the profile.
+func (p *ssoProvider) accessToken() (string, error) {
+	hash := sha1.Sum([]byte(p.StartURL))
+	filename := filepath.Join(p.CacheDir, hex.EncodeToString(hash[:])+".json")
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("Error reading AWS SSO token cache (run `aws sso login` first): %s", err)
+	}
+
+	var cache struct {
+		AccessToken string `json:"accessToken"`
+		ExpiresAt   string `json:"expiresAt"`
+	}
+	if err := json.Unmarshal(b, &cache); err != nil {
+		return "", fmt.Errorf("Error parsing AWS SSO token cache %q: %s", filename, err)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, cache.ExpiresAt)
+	if err != nil {
+		// Older versions of the AWS CLI use a "UTC" suffix
+		expiresAt, err = time.Parse("2006-01-02T15:04:05UTC", cache.ExpiresAt)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Error parsing expiry of AWS SSO token cache %q: %s", filename, err)
+	}
+	if time.Now().After(expiresAt) {
+		return "", fmt.Errorf("The AWS SSO session for %s has expired, run `aws sso login` to refresh it", p.StartURL)
+	}
+
+	return cache.AccessToken, nil
+}
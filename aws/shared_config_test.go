@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const sharedConfigFileContents = `
+[default]
+region = us-east-1
+
+[profile hub]
+role_arn = arn:aws:iam::111111111111:role/hub
+source_profile = base
+duration_seconds = 3600
+
+[profile workload]
+role_arn = arn:aws:iam::222222222222:role/workload
+source_profile = hub
+external_id = ext
+
+[profile self]
+role_arn = arn:aws:iam::333333333333:role/self
+source_profile = self
+
+[profile process]
+credential_process = echo '{"Version": 1, "AccessKeyId": "process", "SecretAccessKey": "secret"}'
+
+[profile loop1]
+role_arn = arn:aws:iam::111111111111:role/loop1
+source_profile = loop2
+
+[profile loop2]
+role_arn = arn:aws:iam::111111111111:role/loop2
+source_profile = loop1
+
+[profile mfa]
+role_arn = arn:aws:iam::111111111111:role/mfa
+source_profile = base
+mfa_serial = arn:aws:iam::000000000000:mfa/user
+
+[profile ecs]
+role_arn = arn:aws:iam::444444444444:role/ecs
+credential_source = EcsContainer
+
+[profile nosource]
+role_arn = arn:aws:iam::111111111111:role/nosource
+`
+
+const sharedConfigCredentialsFileContents = `
+[base]
+aws_access_key_id = base
+aws_secret_access_key = base
+
+[self]
+aws_access_key_id = self
+aws_secret_access_key = self
+`
+
+func TestAWSGetCredentials_sharedConfig(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	requests, closeFunc := stsAssumeRoleMock(t)
+	defer closeFunc()
+
+	configFile := testWriteTempFile(t, "terraform_aws_config", sharedConfigFileContents)
+	defer os.Remove(configFile)
+	credsFile := testWriteTempFile(t, "terraform_aws_cred", sharedConfigCredentialsFileContents)
+	defer os.Remove(credsFile)
+
+	cases := []struct {
+		Profile  string
+		Key      string
+		Requests []string
+	}{
+		{
+			Profile: "base",
+			Key:     "base",
+		},
+		{
+			Profile: "hub",
+			Key:     "hub",
+			Requests: []string{
+				"base AssumeRole arn:aws:iam::111111111111:role/hub DurationSeconds=3600",
+			},
+		},
+		{
+			Profile: "workload",
+			Key:     "workload",
+			Requests: []string{
+				"base AssumeRole arn:aws:iam::111111111111:role/hub DurationSeconds=3600",
+				"hub AssumeRole arn:aws:iam::222222222222:role/workload DurationSeconds=900",
+			},
+		},
+		{
+			Profile: "self",
+			Key:     "self",
+			Requests: []string{
+				"self AssumeRole arn:aws:iam::333333333333:role/self DurationSeconds=900",
+			},
+		},
+		{
+			Profile: "process",
+			Key:     "process",
+		},
+		{
+			Profile: "ecs",
+			Key:     "ecs",
+			Requests: []string{
+				"container AssumeRole arn:aws:iam::444444444444:role/ecs DurationSeconds=900",
+			},
+		},
+	}
+
+	ecsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"AccessKeyId": "container", "SecretAccessKey": "container", "Token": "container", "Expiration": %q}`,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer ecsServer.Close()
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", ecsServer.URL)
+	defer os.Unsetenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+
+	for _, tc := range cases {
+		*requests = nil
+		creds, err := GetCredentials(&Config{
+			Profile:              tc.Profile,
+			CredsFilename:        credsFile,
+			SharedConfigFilename: configFile,
+			Region:               "us-east-1",
+			SkipMetadataApiCheck: true,
+			Endpoints:            map[string]string{"sts": stsAssumeRoleMockURL},
+		})
+		if err != nil {
+			t.Fatalf("%s: Error getting creds: %s", tc.Profile, err)
+		}
+
+		v, err := creds.Get()
+		if err != nil {
+			t.Fatalf("%s: Error getting creds: %s", tc.Profile, err)
+		}
+		if v.AccessKeyID != tc.Key {
+			t.Fatalf("%s: AccessKeyID mismatch, expected: (%s), got (%s)", tc.Profile, tc.Key, v.AccessKeyID)
+		}
+		if !reflect.DeepEqual(*requests, tc.Requests) {
+			t.Fatalf("%s: Requests mismatch, expected: %q, got %q", tc.Profile, tc.Requests, *requests)
+		}
+	}
+
+	// Profiles we can't handle are skipped, leaving no credentials
+	for _, profile := range []string{"loop1", "mfa", "nosource"} {
+		creds, err := GetCredentials(&Config{
+			Profile:              profile,
+			CredsFilename:        credsFile,
+			SharedConfigFilename: configFile,
+			SkipMetadataApiCheck: true,
+		})
+		if err != nil {
+			t.Fatalf("%s: Error getting creds: %s", profile, err)
+		}
+		if _, err := creds.Get(); err == nil {
+			t.Fatalf("%s: Expected an error", profile)
+		}
+	}
+}
+
+func TestAWSGetCredentials_sharedConfigIgnoredWithStaticCredentials(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	configFile := testWriteTempFile(t, "terraform_aws_config", `
+[default]
+role_arn = arn:aws:iam::111111111111:role/mfa
+source_profile = default
+mfa_serial = arn:aws:iam::000000000000:mfa/user
+
+[profile credsource]
+role_arn = arn:aws:iam::111111111111:role/credsource
+credential_source = Ec2InstanceMetadata
+`)
+	defer os.Remove(configFile)
+
+	for _, profile := range []string{"", "credsource"} {
+		creds, err := GetCredentials(&Config{
+			AccessKey:            "static",
+			SecretKey:            "static",
+			Profile:              profile,
+			SharedConfigFilename: configFile,
+			SkipMetadataApiCheck: true,
+		})
+		if err != nil {
+			t.Fatalf("%q: Error getting creds: %s", profile, err)
+		}
+
+		v, err := creds.Get()
+		if err != nil {
+			t.Fatalf("%q: Error getting creds: %s", profile, err)
+		}
+		if v.AccessKeyID != "static" {
+			t.Fatalf("%q: AccessKeyID mismatch, expected: (static), got (%s)", profile, v.AccessKeyID)
+		}
+	}
+}
+
+func TestAWSCredentialProcessProvider_cachesUntilExpiry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_credential_process")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The process counts how often it ran in a file
+	counter := filepath.Join(dir, "counter")
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	command := fmt.Sprintf(`sh -c 'echo x >> %s; echo "{\"Version\": 1, \"AccessKeyId\": \"key\", \"SecretAccessKey\": \"secret\", \"Expiration\": \"%s\"}"'`,
+		counter, expiration)
+
+	for i := 0; i < 3; i++ {
+		p := &credentialProcessProvider{Command: command}
+		if i == 0 && !p.IsExpired() {
+			t.Fatal("Expected credentials to be expired before they were retrieved")
+		}
+
+		v, err := p.Retrieve()
+		if err != nil {
+			t.Fatalf("Error retrieving credentials: %s", err)
+		}
+		if v.AccessKeyID != "key" {
+			t.Fatalf("AccessKeyID mismatch, expected: (key), got (%s)", v.AccessKeyID)
+		}
+		if p.IsExpired() {
+			t.Fatal("Expected credentials not to be expired")
+		}
+	}
+
+	b, err := ioutil.ReadFile(counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runs := strings.Count(string(b), "x"); runs != 1 {
+		t.Fatalf("Expected credential_process to run once, ran %d times", runs)
+	}
+}
+
+func TestAWSSSOProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_sso_cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	startURL := "https://example.awsapps.com/start"
+	hash := sha1.Sum([]byte(startURL))
+	cache := fmt.Sprintf(`{"startUrl": %q, "region": "us-east-1", "accessToken": "token", "expiresAt": %q}`,
+		startURL, time.Now().Add(time.Hour).UTC().Format("2006-01-02T15:04:05UTC"))
+	if err := ioutil.WriteFile(filepath.Join(dir, hex.EncodeToString(hash[:])+".json"), []byte(cache), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	expiration := time.Now().Add(time.Hour)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/federation/credentials" ||
+			r.URL.Query().Get("account_id") != "123456789012" ||
+			r.URL.Query().Get("role_name") != "admin" ||
+			r.Header.Get("x-amz-sso_bearer_token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprintf(w, `{"roleCredentials": {"accessKeyId": "sso", "secretAccessKey": "secret", "sessionToken": "session", "expiration": %d}}`,
+			expiration.UnixNano()/int64(time.Millisecond))
+	}))
+	defer ts.Close()
+
+	p := &ssoProvider{
+		StartURL:  startURL,
+		Region:    "us-east-1",
+		AccountID: "123456789012",
+		RoleName:  "admin",
+		CacheDir:  dir,
+		Endpoint:  ts.URL,
+	}
+
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if v.AccessKeyID != "sso" || v.SessionToken != "session" {
+		t.Fatalf("Unexpected credentials: %#v", v)
+	}
+	if p.IsExpired() {
+		t.Fatal("Expected credentials not to be expired")
+	}
+
+	p.StartURL = "https://other.awsapps.com/start"
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatal("Expected an error without a cached token")
+	}
+}
+
+func testWriteTempFile(t *testing.T, prefix, contents string) string {
+	file, err := ioutil.TempFile(os.TempDir(), prefix)
+	if err != nil {
+		t.Fatalf("Error creating temporary file: %s", err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(contents); err != nil {
+		t.Fatalf("Error writing temporary file: %s", err)
+	}
+	return file.Name()
+}
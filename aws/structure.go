@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -28,6 +29,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -2075,6 +2077,471 @@ func flattenCognitoIdentityProviders(ips []*cognitoidentity.Provider) []map[stri
 	return values
 }
 
+func expandCognitoIdentityPoolRoleMappings(l []interface{}) map[string]*cognitoidentity.RoleMapping {
+	mappings := make(map[string]*cognitoidentity.RoleMapping, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		mapping := &cognitoidentity.RoleMapping{
+			AmbiguousRoleResolution: aws.String(m["ambiguous_role_resolution"].(string)),
+			Type:                    aws.String(m["type"].(string)),
+		}
+
+		if rules := m["mapping_rule"].([]interface{}); len(rules) > 0 {
+			mapping.RulesConfiguration = &cognitoidentity.RulesConfigurationType{
+				Rules: expandCognitoIdentityPoolMappingRules(rules),
+			}
+		}
+
+		mappings[m["identity_provider"].(string)] = mapping
+	}
+	return mappings
+}
+
+func expandCognitoIdentityPoolMappingRules(l []interface{}) []*cognitoidentity.MappingRule {
+	rules := make([]*cognitoidentity.MappingRule, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		rules = append(rules, &cognitoidentity.MappingRule{
+			Claim:     aws.String(m["claim"].(string)),
+			MatchType: aws.String(m["match_type"].(string)),
+			RoleARN:   aws.String(m["role_arn"].(string)),
+			Value:     aws.String(m["value"].(string)),
+		})
+	}
+	return rules
+}
+
+func flattenCognitoIdentityPoolRoleMappings(mappings map[string]*cognitoidentity.RoleMapping) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(mappings))
+	for provider, mapping := range mappings {
+		m := map[string]interface{}{
+			"identity_provider":         provider,
+			"ambiguous_role_resolution": aws.StringValue(mapping.AmbiguousRoleResolution),
+			"type":                      aws.StringValue(mapping.Type),
+		}
+
+		if mapping.RulesConfiguration != nil {
+			rules := make([]map[string]interface{}, 0, len(mapping.RulesConfiguration.Rules))
+			for _, rule := range mapping.RulesConfiguration.Rules {
+				rules = append(rules, map[string]interface{}{
+					"claim":      aws.StringValue(rule.Claim),
+					"match_type": aws.StringValue(rule.MatchType),
+					"role_arn":   aws.StringValue(rule.RoleARN),
+					"value":      aws.StringValue(rule.Value),
+				})
+			}
+			m["mapping_rule"] = rules
+		}
+
+		result = append(result, m)
+	}
+	return result
+}
+
+func expandCognitoUserPoolAdminCreateUserConfig(l []interface{}) *cognitoidentityprovider.AdminCreateUserConfigType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	config := &cognitoidentityprovider.AdminCreateUserConfigType{
+		AllowAdminCreateUserOnly:  aws.Bool(m["allow_admin_create_user_only"].(bool)),
+		UnusedAccountValidityDays: aws.Int64(int64(m["unused_account_validity_days"].(int))),
+	}
+
+	if v, ok := m["invite_message_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		t := v[0].(map[string]interface{})
+		config.InviteMessageTemplate = &cognitoidentityprovider.MessageTemplateType{}
+		if v, ok := t["email_message"].(string); ok && v != "" {
+			config.InviteMessageTemplate.EmailMessage = aws.String(v)
+		}
+		if v, ok := t["email_subject"].(string); ok && v != "" {
+			config.InviteMessageTemplate.EmailSubject = aws.String(v)
+		}
+		if v, ok := t["sms_message"].(string); ok && v != "" {
+			config.InviteMessageTemplate.SMSMessage = aws.String(v)
+		}
+	}
+
+	return config
+}
+
+func flattenCognitoUserPoolAdminCreateUserConfig(config *cognitoidentityprovider.AdminCreateUserConfigType) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"allow_admin_create_user_only": aws.BoolValue(config.AllowAdminCreateUserOnly),
+		"unused_account_validity_days": aws.Int64Value(config.UnusedAccountValidityDays),
+	}
+
+	if t := config.InviteMessageTemplate; t != nil && (t.EmailMessage != nil || t.EmailSubject != nil || t.SMSMessage != nil) {
+		m["invite_message_template"] = []map[string]interface{}{
+			{
+				"email_message": aws.StringValue(t.EmailMessage),
+				"email_subject": aws.StringValue(t.EmailSubject),
+				"sms_message":   aws.StringValue(t.SMSMessage),
+			},
+		}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandCognitoUserPoolDeviceConfiguration(l []interface{}) *cognitoidentityprovider.DeviceConfigurationType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.DeviceConfigurationType{
+		ChallengeRequiredOnNewDevice:     aws.Bool(m["challenge_required_on_new_device"].(bool)),
+		DeviceOnlyRememberedOnUserPrompt: aws.Bool(m["device_only_remembered_on_user_prompt"].(bool)),
+	}
+}
+
+func flattenCognitoUserPoolDeviceConfiguration(config *cognitoidentityprovider.DeviceConfigurationType) []map[string]interface{} {
+	if config == nil || (config.ChallengeRequiredOnNewDevice == nil && config.DeviceOnlyRememberedOnUserPrompt == nil) {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"challenge_required_on_new_device":      aws.BoolValue(config.ChallengeRequiredOnNewDevice),
+			"device_only_remembered_on_user_prompt": aws.BoolValue(config.DeviceOnlyRememberedOnUserPrompt),
+		},
+	}
+}
+
+func expandCognitoUserPoolEmailConfiguration(l []interface{}) *cognitoidentityprovider.EmailConfigurationType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	config := &cognitoidentityprovider.EmailConfigurationType{}
+	if v, ok := m["reply_to_email_address"].(string); ok && v != "" {
+		config.ReplyToEmailAddress = aws.String(v)
+	}
+	if v, ok := m["source_arn"].(string); ok && v != "" {
+		config.SourceArn = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenCognitoUserPoolEmailConfiguration(config *cognitoidentityprovider.EmailConfigurationType) []map[string]interface{} {
+	if config == nil || (config.ReplyToEmailAddress == nil && config.SourceArn == nil) {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"reply_to_email_address": aws.StringValue(config.ReplyToEmailAddress),
+			"source_arn":             aws.StringValue(config.SourceArn),
+		},
+	}
+}
+
+func expandCognitoUserPoolLambdaConfig(l []interface{}) *cognitoidentityprovider.LambdaConfigType {
+	config := &cognitoidentityprovider.LambdaConfigType{}
+	if len(l) == 0 || l[0] == nil {
+		// An empty configuration removes all of the triggers.
+		return config
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["create_auth_challenge"].(string); ok && v != "" {
+		config.CreateAuthChallenge = aws.String(v)
+	}
+	if v, ok := m["custom_message"].(string); ok && v != "" {
+		config.CustomMessage = aws.String(v)
+	}
+	if v, ok := m["define_auth_challenge"].(string); ok && v != "" {
+		config.DefineAuthChallenge = aws.String(v)
+	}
+	if v, ok := m["post_authentication"].(string); ok && v != "" {
+		config.PostAuthentication = aws.String(v)
+	}
+	if v, ok := m["post_confirmation"].(string); ok && v != "" {
+		config.PostConfirmation = aws.String(v)
+	}
+	if v, ok := m["pre_authentication"].(string); ok && v != "" {
+		config.PreAuthentication = aws.String(v)
+	}
+	if v, ok := m["pre_sign_up"].(string); ok && v != "" {
+		config.PreSignUp = aws.String(v)
+	}
+	if v, ok := m["verify_auth_challenge_response"].(string); ok && v != "" {
+		config.VerifyAuthChallengeResponse = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenCognitoUserPoolLambdaConfig(config *cognitoidentityprovider.LambdaConfigType) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+	for k, v := range map[string]*string{
+		"create_auth_challenge":          config.CreateAuthChallenge,
+		"custom_message":                 config.CustomMessage,
+		"define_auth_challenge":          config.DefineAuthChallenge,
+		"post_authentication":            config.PostAuthentication,
+		"post_confirmation":              config.PostConfirmation,
+		"pre_authentication":             config.PreAuthentication,
+		"pre_sign_up":                    config.PreSignUp,
+		"verify_auth_challenge_response": config.VerifyAuthChallengeResponse,
+	} {
+		if v != nil {
+			m[k] = *v
+		}
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandCognitoUserPoolPolicies(l []interface{}) *cognitoidentityprovider.UserPoolPolicyType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	policy := &cognitoidentityprovider.PasswordPolicyType{
+		RequireLowercase: aws.Bool(m["require_lowercase"].(bool)),
+		RequireNumbers:   aws.Bool(m["require_numbers"].(bool)),
+		RequireSymbols:   aws.Bool(m["require_symbols"].(bool)),
+		RequireUppercase: aws.Bool(m["require_uppercase"].(bool)),
+	}
+	if v, ok := m["minimum_length"].(int); ok && v > 0 {
+		policy.MinimumLength = aws.Int64(int64(v))
+	}
+
+	return &cognitoidentityprovider.UserPoolPolicyType{
+		PasswordPolicy: policy,
+	}
+}
+
+func flattenCognitoUserPoolPasswordPolicy(policies *cognitoidentityprovider.UserPoolPolicyType) []map[string]interface{} {
+	if policies == nil || policies.PasswordPolicy == nil {
+		return nil
+	}
+	policy := policies.PasswordPolicy
+
+	return []map[string]interface{}{
+		{
+			"minimum_length":    aws.Int64Value(policy.MinimumLength),
+			"require_lowercase": aws.BoolValue(policy.RequireLowercase),
+			"require_numbers":   aws.BoolValue(policy.RequireNumbers),
+			"require_symbols":   aws.BoolValue(policy.RequireSymbols),
+			"require_uppercase": aws.BoolValue(policy.RequireUppercase),
+		},
+	}
+}
+
+func expandCognitoUserPoolSchema(l []interface{}) []*cognitoidentityprovider.SchemaAttributeType {
+	attributes := make([]*cognitoidentityprovider.SchemaAttributeType, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		attribute := &cognitoidentityprovider.SchemaAttributeType{
+			AttributeDataType:      aws.String(m["attribute_data_type"].(string)),
+			DeveloperOnlyAttribute: aws.Bool(m["developer_only_attribute"].(bool)),
+			Mutable:                aws.Bool(m["mutable"].(bool)),
+			Name:                   aws.String(m["name"].(string)),
+			Required:               aws.Bool(m["required"].(bool)),
+		}
+
+		if v, ok := m["number_attribute_constraints"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			c := v[0].(map[string]interface{})
+			attribute.NumberAttributeConstraints = &cognitoidentityprovider.NumberAttributeConstraintsType{}
+			if v := c["min_value"].(string); v != "" {
+				attribute.NumberAttributeConstraints.MinValue = aws.String(v)
+			}
+			if v := c["max_value"].(string); v != "" {
+				attribute.NumberAttributeConstraints.MaxValue = aws.String(v)
+			}
+		}
+
+		if v, ok := m["string_attribute_constraints"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			c := v[0].(map[string]interface{})
+			attribute.StringAttributeConstraints = &cognitoidentityprovider.StringAttributeConstraintsType{}
+			if v := c["min_length"].(string); v != "" {
+				attribute.StringAttributeConstraints.MinLength = aws.String(v)
+			}
+			if v := c["max_length"].(string); v != "" {
+				attribute.StringAttributeConstraints.MaxLength = aws.String(v)
+			}
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes
+}
+
+// flattenCognitoUserPoolSchema returns the schema attributes of a user pool.
+// DescribeUserPool returns all of the standard attributes, so only custom
+// attributes and the configured standard attributes are kept. Custom
+// attributes are returned with a "custom:" (and "dev:" for developer only
+// attributes) prefix, which isn't part of the configured name. The
+// constraints of an attribute are only kept if they are configured, as
+// they default to the service's limits.
+func flattenCognitoUserPoolSchema(configured []interface{}, attributes []*cognitoidentityprovider.SchemaAttributeType) []map[string]interface{} {
+	configuredAttributes := make(map[string]map[string]interface{})
+	for _, raw := range configured {
+		m := raw.(map[string]interface{})
+		configuredAttributes[m["name"].(string)] = m
+	}
+
+	values := make([]map[string]interface{}, 0)
+	for _, attribute := range attributes {
+		name := strings.TrimPrefix(aws.StringValue(attribute.Name), "dev:")
+		custom := strings.HasPrefix(name, "custom:")
+		name = strings.TrimPrefix(name, "custom:")
+
+		c, ok := configuredAttributes[name]
+		if !custom && !ok {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"attribute_data_type":      aws.StringValue(attribute.AttributeDataType),
+			"developer_only_attribute": aws.BoolValue(attribute.DeveloperOnlyAttribute),
+			"mutable":                  aws.BoolValue(attribute.Mutable),
+			"name":                     name,
+			"required":                 aws.BoolValue(attribute.Required),
+		}
+
+		if v := attribute.NumberAttributeConstraints; v != nil && (v.MinValue != nil || v.MaxValue != nil) {
+			if l, _ := c["number_attribute_constraints"].([]interface{}); c == nil || len(l) > 0 {
+				m["number_attribute_constraints"] = []map[string]interface{}{
+					{
+						"min_value": aws.StringValue(v.MinValue),
+						"max_value": aws.StringValue(v.MaxValue),
+					},
+				}
+			}
+		}
+
+		if v := attribute.StringAttributeConstraints; v != nil && (v.MinLength != nil || v.MaxLength != nil) {
+			if l, _ := c["string_attribute_constraints"].([]interface{}); c == nil || len(l) > 0 {
+				m["string_attribute_constraints"] = []map[string]interface{}{
+					{
+						"min_length": aws.StringValue(v.MinLength),
+						"max_length": aws.StringValue(v.MaxLength),
+					},
+				}
+			}
+		}
+
+		values = append(values, m)
+	}
+
+	return values
+}
+
+func expandCognitoUserPoolSmsConfiguration(l []interface{}) *cognitoidentityprovider.SmsConfigurationType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.SmsConfigurationType{
+		ExternalId:   aws.String(m["external_id"].(string)),
+		SnsCallerArn: aws.String(m["sns_caller_arn"].(string)),
+	}
+}
+
+func flattenCognitoUserPoolSmsConfiguration(config *cognitoidentityprovider.SmsConfigurationType) []map[string]interface{} {
+	if config == nil || config.SnsCallerArn == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"external_id":    aws.StringValue(config.ExternalId),
+			"sns_caller_arn": aws.StringValue(config.SnsCallerArn),
+		},
+	}
+}
+
+func expandCognitoResourceServerScopes(l []interface{}) []*cognitoidentityprovider.ResourceServerScopeType {
+	scopes := make([]*cognitoidentityprovider.ResourceServerScopeType, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		scopes = append(scopes, &cognitoidentityprovider.ResourceServerScopeType{
+			ScopeDescription: aws.String(m["scope_description"].(string)),
+			ScopeName:        aws.String(m["scope_name"].(string)),
+		})
+	}
+	return scopes
+}
+
+func flattenCognitoResourceServerScopes(scopes []*cognitoidentityprovider.ResourceServerScopeType) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(scopes))
+	for _, scope := range scopes {
+		result = append(result, map[string]interface{}{
+			"scope_description": aws.StringValue(scope.ScopeDescription),
+			"scope_name":        aws.StringValue(scope.ScopeName),
+		})
+	}
+	return result
+}
+
+func expandCognitoUserPoolVerificationMessageTemplate(l []interface{}) *cognitoidentityprovider.VerificationMessageTemplateType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	template := &cognitoidentityprovider.VerificationMessageTemplateType{
+		DefaultEmailOption: aws.String(m["default_email_option"].(string)),
+	}
+	if v, ok := m["email_message"].(string); ok && v != "" {
+		template.EmailMessage = aws.String(v)
+	}
+	if v, ok := m["email_message_by_link"].(string); ok && v != "" {
+		template.EmailMessageByLink = aws.String(v)
+	}
+	if v, ok := m["email_subject"].(string); ok && v != "" {
+		template.EmailSubject = aws.String(v)
+	}
+	if v, ok := m["email_subject_by_link"].(string); ok && v != "" {
+		template.EmailSubjectByLink = aws.String(v)
+	}
+	if v, ok := m["sms_message"].(string); ok && v != "" {
+		template.SmsMessage = aws.String(v)
+	}
+
+	return template
+}
+
+func flattenCognitoUserPoolVerificationMessageTemplate(template *cognitoidentityprovider.VerificationMessageTemplateType) []map[string]interface{} {
+	if template == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"default_email_option":  aws.StringValue(template.DefaultEmailOption),
+			"email_message":         aws.StringValue(template.EmailMessage),
+			"email_message_by_link": aws.StringValue(template.EmailMessageByLink),
+			"email_subject":         aws.StringValue(template.EmailSubject),
+			"email_subject_by_link": aws.StringValue(template.EmailSubjectByLink),
+			"sms_message":           aws.StringValue(template.SmsMessage),
+		},
+	}
+}
+
 func buildLambdaInvokeArn(lambdaArn, region string) string {
 	apiVersion := "2015-03-31"
 	return fmt.Sprintf("arn:aws:apigateway:%s:lambda:path/%s/functions/%s/invocations",
@@ -2148,6 +2615,41 @@ func flattenFieldToMatch(fm *waf.FieldToMatch) []interface{} {
 	return []interface{}{m}
 }
 
+func expandServiceCatalogProvisioningArtifactProperties(l []interface{}) *servicecatalog.ProvisioningArtifactProperties {
+	m := l[0].(map[string]interface{})
+
+	properties := &servicecatalog.ProvisioningArtifactProperties{
+		Info: map[string]*string{
+			"LoadTemplateFromURL": aws.String(m["template_url"].(string)),
+		},
+		Type: aws.String(m["type"].(string)),
+	}
+	if v, ok := m["name"]; ok && v.(string) != "" {
+		properties.Name = aws.String(v.(string))
+	}
+	if v, ok := m["description"]; ok && v.(string) != "" {
+		properties.Description = aws.String(v.(string))
+	}
+
+	return properties
+}
+
+func flattenServiceCatalogProvisioningArtifact(detail *servicecatalog.ProvisioningArtifactDetail, templateUrl string) []map[string]interface{} {
+	if detail == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"id":           aws.StringValue(detail.Id),
+		"name":         aws.StringValue(detail.Name),
+		"description":  aws.StringValue(detail.Description),
+		"type":         aws.StringValue(detail.Type),
+		"template_url": templateUrl,
+	}
+
+	return []map[string]interface{}{m}
+}
+
 // escapeJsonPointer escapes string per RFC 6901
 // so it can be used as path in JSON patch operations
 func escapeJsonPointer(path string) string {
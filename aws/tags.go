@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 // tagsSchema returns the schema to use for tags.
@@ -32,11 +33,169 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// tagsChange returns the prior and desired values of the "tags" attribute and
+// whether they need to be reconciled with AWS. The desired value is read with
+// d.Get rather than from the diff so that it includes any provider-level
+// default_tags merged in by providerTagsResource, and a new resource is always
+// reconciled so that default tags are applied even if it sets no tags itself.
+func tagsChange(d *schema.ResourceData) (map[string]interface{}, map[string]interface{}, bool) {
+	oraw, _ := d.GetChange("tags")
+	o := oraw.(map[string]interface{})
+	n := d.Get("tags").(map[string]interface{})
+
+	return o, n, d.HasChange("tags") || (d.IsNewResource() && len(n) > 0)
+}
+
+// mergeDefaultTags returns the given resource tags layered on top of the
+// provider's default tags. Resource tags win on conflict.
+func mergeDefaultTags(defaults, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaults)+len(tags))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeIgnoredTags strips from tags every tag whose key is listed in keys or
+// starts with one of prefixes.
+func removeIgnoredTags(tags map[string]interface{}, keys, prefixes []string) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if tagKeyIgnored(k, keys, prefixes) {
+			log.Printf("[DEBUG] Found tag %s matching ignore_tags, ignoring.", k)
+			continue
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// tagKeyIgnored reports whether key is listed in keys or starts with one of
+// prefixes.
+func tagKeyIgnored(key string, keys, prefixes []string) bool {
+	for _, k := range keys {
+		if key == k {
+			return true
+		}
+	}
+	for _, p := range prefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// providerTagsResource wraps the Create, Read and Update functions of a
+// resource with a "tags" map so that the provider's default_tags and
+// ignore_tags settings apply to it. Before Create and Update the default tags
+// are merged into the "tags" attribute, so that both the create inputs and the
+// setTags* helpers send them. After every operation ignored tags are dropped,
+// keeping them out of the plan. Since ignored tags never make it into state,
+// the setTags* helpers never try to remove them either. The default tags stay
+// in state, where providerTagsConfig compares them with the configured ones.
+func providerTagsResource(r *schema.Resource) {
+	if s, ok := r.Schema["tags"]; !ok || s.Type != schema.TypeMap {
+		return
+	}
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error, merge bool) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			client, ok := meta.(*AWSClient)
+			if !ok || (len(client.defaultTags) == 0 && len(client.ignoreTagKeys) == 0 && len(client.ignoreTagKeyPrefixes) == 0) {
+				return f(d, meta)
+			}
+
+			if merge && len(client.defaultTags) > 0 {
+				tags := d.Get("tags").(map[string]interface{})
+				if err := d.Set("tags", mergeDefaultTags(client.defaultTags, tags)); err != nil {
+					return err
+				}
+			}
+
+			err := f(d, meta)
+
+			if d.Id() != "" {
+				tags := d.Get("tags").(map[string]interface{})
+				tags = removeIgnoredTags(tags, client.ignoreTagKeys, client.ignoreTagKeyPrefixes)
+				if serr := d.Set("tags", tags); serr != nil && err == nil {
+					err = serr
+				}
+			}
+
+			return err
+		}
+	}
+
+	r.Create = wrap(r.Create, true)
+	r.Read = wrap(r.Read, false)
+	r.Update = wrap(r.Update, true)
+}
+
+// providerTagsConfig returns the configuration of a resource with the
+// provider's default_tags merged into its "tags", for the diff to compare
+// with the tags in state. Read saves all of a resource's tags to state, so
+// adding, changing or removing a default tag shows up as an in-place update
+// of the tags. Default tags that are ignored aren't merged, and neither are
+// tags whose value isn't known yet.
+func providerTagsConfig(r *schema.Resource, c *terraform.ResourceConfig, meta interface{}) *terraform.ResourceConfig {
+	client, ok := meta.(*AWSClient)
+	if !ok || len(client.defaultTags) == 0 || c == nil {
+		return c
+	}
+	if s, ok := r.Schema["tags"]; !ok || s.Type != schema.TypeMap {
+		return c
+	}
+	if c.IsComputed("tags") {
+		return c
+	}
+
+	tags := make(map[string]interface{})
+	if v, ok := c.Get("tags"); ok {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, v := range v {
+				tags[k] = v
+			}
+		case []map[string]interface{}:
+			for _, m := range v {
+				for k, v := range m {
+					tags[k] = v
+				}
+			}
+		default:
+			return c
+		}
+	}
+
+	defaults := removeIgnoredTags(client.defaultTags, client.ignoreTagKeys, client.ignoreTagKeyPrefixes)
+	tags = mergeDefaultTags(defaults, tags)
+
+	merged := *c
+	merged.Raw = make(map[string]interface{}, len(c.Raw)+1)
+	for k, v := range c.Raw {
+		merged.Raw[k] = v
+	}
+	merged.Raw["tags"] = tags
+	merged.Config = make(map[string]interface{}, len(c.Config)+1)
+	for k, v := range c.Config {
+		merged.Config[k] = v
+	}
+	merged.Config["tags"] = tags
+
+	return &merged
+}
+
 func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); ok {
 		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
 
 		// Set tags
@@ -129,10 +288,7 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); ok {
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
 
 		// Set tags
@@ -333,11 +489,8 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
 func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+	if o, n, ok := tagsChange(d); ok {
 		arn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
 		create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
 
 		// Set tags
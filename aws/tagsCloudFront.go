@@ -9,10 +9,7 @@ import (
 )
 
 func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); ok {
 		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
 
 		if len(remove) > 0 {
@@ -12,10 +12,7 @@ import (
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, resourceId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); ok {
 		create, remove := diffTagsDS(tagsFromMapDS(o), tagsFromMapDS(n))
 
 		// Set tags
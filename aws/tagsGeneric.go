@@ -54,6 +54,22 @@ func tagsToMapGeneric(ts map[string]*string) map[string]string {
 	return result
 }
 
+// mergeIgnoredTagsGeneric adds the tags of live whose keys match ignoreKeys or
+// ignorePrefixes to tags, for APIs that replace the whole tag set.
+func mergeIgnoredTagsGeneric(tags, live map[string]*string, ignoreKeys, ignorePrefixes []string) map[string]*string {
+	for k, v := range live {
+		if _, ok := tags[k]; ok {
+			continue
+		}
+		if tagKeyIgnored(k, ignoreKeys, ignorePrefixes) {
+			log.Printf("[DEBUG] Keeping unmanaged tag %s", k)
+			tags[k] = v
+		}
+	}
+
+	return tags
+}
+
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredGeneric(k string) bool {
@@ -71,3 +71,28 @@ func TestIgnoringTagsGeneric(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeIgnoredTagsGeneric(t *testing.T) {
+	tags := map[string]*string{
+		"Name": aws.String("pool"),
+		"team": aws.String("new"),
+	}
+	live := map[string]*string{
+		"Name":              aws.String("old"),
+		"Owner":             aws.String("removed"),
+		"team":              aws.String("old"),
+		"CostCenter":        aws.String("1234"),
+		"kubernetes.io/foo": aws.String("owned"),
+	}
+
+	result := tagsToMapGeneric(mergeIgnoredTagsGeneric(tags, live, []string{"CostCenter", "team"}, []string{"kubernetes.io/"}))
+	expected := map[string]string{
+		"Name":              "pool",
+		"team":              "new",
+		"CostCenter":        "1234",
+		"kubernetes.io/foo": "owned",
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, result)
+	}
+}
@@ -59,10 +59,7 @@ func dmsGetTagKeys(tags []*dms.Tag) []*string {
 func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dmsconn
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); ok {
 
 		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
 
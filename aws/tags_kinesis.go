@@ -15,10 +15,7 @@ func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
 
 	sn := d.Get("name").(string)
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, ok := tagsChange(d); ok {
 		create, remove := diffTagsKinesis(tagsFromMapKinesis(o), tagsFromMapKinesis(n))
 
 		// Set tags
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// diffTagsServiceCatalog takes our tags locally and the ones remotely and
+// returns the set of tags that must be added, and the keys of the tags that
+// must be removed. Service Catalog overwrites the value of an existing tag
+// when it is added again, so changed tags are only added.
+func diffTagsServiceCatalog(oldTags, newTags []*servicecatalog.Tag) ([]*servicecatalog.Tag, []*string) {
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[*t.Key] = *t.Value
+	}
+
+	var remove []*string
+	for _, t := range oldTags {
+		if _, ok := create[*t.Key]; !ok {
+			remove = append(remove, t.Key)
+		}
+	}
+
+	return tagsFromMapServiceCatalog(create), remove
+}
+
+// tagsFromMapServiceCatalog returns the tags for the given map of data.
+func tagsFromMapServiceCatalog(m map[string]interface{}) []*servicecatalog.Tag {
+	var result []*servicecatalog.Tag
+	for k, v := range m {
+		t := &servicecatalog.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		}
+		if !tagIgnoredServiceCatalog(t) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// tagsToMapServiceCatalog turns the list of tags into a map.
+func tagsToMapServiceCatalog(ts []*servicecatalog.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		if !tagIgnoredServiceCatalog(t) {
+			result[*t.Key] = *t.Value
+		}
+	}
+
+	return result
+}
+
+// compare a tag against a list of strings and checks if it should
+// be ignored or not
+func tagIgnoredServiceCatalog(t *servicecatalog.Tag) bool {
+	filter := []string{"^aws:"}
+	for _, v := range filter {
+		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
+		if r, _ := regexp.MatchString(v, *t.Key); r == true {
+			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
+			return true
+		}
+	}
+	return false
+}
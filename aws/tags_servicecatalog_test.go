@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+func TestDiffTagsServiceCatalog(t *testing.T) {
+	cases := []struct {
+		Old, New map[string]interface{}
+		Create   map[string]string
+		Remove   []string
+	}{
+		// Basic add/remove
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"bar": "baz",
+			},
+			Create: map[string]string{
+				"bar": "baz",
+			},
+			Remove: []string{"foo"},
+		},
+
+		// Modify
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "baz",
+			},
+			Create: map[string]string{
+				"foo": "baz",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		c, r := diffTagsServiceCatalog(tagsFromMapServiceCatalog(tc.Old), tagsFromMapServiceCatalog(tc.New))
+		cm := tagsToMapServiceCatalog(c)
+		rl := aws.StringValueSlice(r)
+		if !reflect.DeepEqual(cm, tc.Create) {
+			t.Fatalf("%d: bad create: %#v", i, cm)
+		}
+		if len(rl) != len(tc.Remove) || (len(rl) > 0 && !reflect.DeepEqual(rl, tc.Remove)) {
+			t.Fatalf("%d: bad remove: %#v", i, rl)
+		}
+	}
+}
+
+func TestIgnoringTagsServiceCatalog(t *testing.T) {
+	var ignoredTags []*servicecatalog.Tag
+	ignoredTags = append(ignoredTags, &servicecatalog.Tag{
+		Key:   aws.String("aws:cloudformation:logical-id"),
+		Value: aws.String("foo"),
+	})
+	ignoredTags = append(ignoredTags, &servicecatalog.Tag{
+		Key:   aws.String("aws:foo:bar"),
+		Value: aws.String("baz"),
+	})
+	for _, tag := range ignoredTags {
+		if !tagIgnoredServiceCatalog(tag) {
+			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
+		}
+	}
+}
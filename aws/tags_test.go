@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -80,6 +81,166 @@ func TestIgnoringTags(t *testing.T) {
 	}
 }
 
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		Defaults, Tags, Expected map[string]interface{}
+	}{
+		// No defaults
+		{
+			Defaults: map[string]interface{}{},
+			Tags: map[string]interface{}{
+				"foo": "bar",
+			},
+			Expected: map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+
+		// Defaults only
+		{
+			Defaults: map[string]interface{}{
+				"env": "prod",
+			},
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"env": "prod",
+			},
+		},
+
+		// Resource tags win
+		{
+			Defaults: map[string]interface{}{
+				"env":   "prod",
+				"owner": "ops",
+			},
+			Tags: map[string]interface{}{
+				"env": "dev",
+			},
+			Expected: map[string]interface{}{
+				"env":   "dev",
+				"owner": "ops",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		m := mergeDefaultTags(tc.Defaults, tc.Tags)
+		if !reflect.DeepEqual(m, tc.Expected) {
+			t.Fatalf("%d: bad merge: %#v", i, m)
+		}
+	}
+}
+
+func TestProviderTagsConfig(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+	p := &awsProvider{
+		Provider: &schema.Provider{
+			ResourcesMap: map[string]*schema.Resource{"aws_test": r},
+		},
+		customizeDiff: map[string]customizeDiffFunc{},
+	}
+	p.SetMeta(&AWSClient{
+		defaultTags:   map[string]interface{}{"Environment": "production", "Team": "infra"},
+		ignoreTagKeys: []string{"Team"},
+	})
+	info := &terraform.InstanceInfo{Type: "aws_test"}
+	config := testAwsResourceConfig(t, map[string]interface{}{
+		"name": "test",
+		"tags": map[string]interface{}{"Name": "test"},
+	})
+
+	cases := []struct {
+		Tags     map[string]string
+		Expected map[string]*terraform.ResourceAttrDiff
+	}{
+		// Default tag already applied
+		{
+			Tags: map[string]string{"Name": "test", "Environment": "production"},
+		},
+
+		// Default tag added
+		{
+			Tags: map[string]string{"Name": "test"},
+			Expected: map[string]*terraform.ResourceAttrDiff{
+				"tags.%":           {Old: "1", New: "2"},
+				"tags.Environment": {Old: "", New: "production"},
+			},
+		},
+
+		// Default tag changed
+		{
+			Tags: map[string]string{"Name": "test", "Environment": "staging"},
+			Expected: map[string]*terraform.ResourceAttrDiff{
+				"tags.Environment": {Old: "staging", New: "production"},
+			},
+		},
+
+		// Default tag removed
+		{
+			Tags: map[string]string{"Name": "test", "Environment": "production", "Owner": "alice"},
+			Expected: map[string]*terraform.ResourceAttrDiff{
+				"tags.%":     {Old: "3", New: "2"},
+				"tags.Owner": {Old: "alice", New: "", NewRemoved: true},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		state := &terraform.InstanceState{
+			ID: "id",
+			Attributes: map[string]string{
+				"id":     "id",
+				"name":   "test",
+				"tags.%": fmt.Sprintf("%d", len(tc.Tags)),
+			},
+		}
+		for k, v := range tc.Tags {
+			state.Attributes["tags."+k] = v
+		}
+
+		diff, err := p.Diff(info, state, config)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if tc.Expected == nil {
+			if diff != nil {
+				t.Fatalf("%d: Expected no diff, got %#v", i, diff)
+			}
+			continue
+		}
+		if diff == nil || !reflect.DeepEqual(diff.Attributes, tc.Expected) {
+			t.Fatalf("%d: Expected diff %#v, got %#v", i, tc.Expected, diff)
+		}
+	}
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	tags := map[string]interface{}{
+		"Name":                         "foo",
+		"kubernetes.io/cluster/test":   "owned",
+		"kubernetes.io/role/elb":       "1",
+		"scanner-last-run":             "2017-08-01",
+		"scanner-last-run-unrelated-x": "bar",
+	}
+	expected := map[string]interface{}{
+		"Name":                         "foo",
+		"scanner-last-run-unrelated-x": "bar",
+	}
+
+	m := removeIgnoredTags(tags, []string{"scanner-last-run"}, []string{"kubernetes.io/"})
+	if !reflect.DeepEqual(m, expected) {
+		t.Fatalf("bad result: %#v", m)
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// httpClient returns a new HTTP client with the transport settings of the
+// provider: the HTTP proxy, the custom CA bundle and insecure TLS. All AWS
+// API clients of the provider, including those used to get credentials,
+// are built with it.
+func (c *Config) httpClient() *http.Client {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	if c.Insecure || c.CustomCABundle != "" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: c.Insecure,
+		}
+	}
+	if c.CustomCABundle != "" {
+		pool, err := caBundleCertPool(c.CustomCABundle)
+		if err != nil {
+			log.Printf("[WARN] Ignoring custom CA bundle: %s", err)
+		} else {
+			transport.TLSClientConfig.RootCAs = pool
+		}
+	}
+
+	var proxyURL *url.URL
+	if c.HTTPProxy != "" {
+		u, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			log.Printf("[WARN] Ignoring HTTP proxy %q: %s", c.HTTPProxy, err)
+		} else {
+			proxyURL = u
+		}
+	}
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		// The instance metadata and other link-local services are never
+		// reachable through a proxy.
+		if linkLocalHost(req.URL.Host) {
+			return nil, nil
+		}
+		if noProxyMatch(c.NoProxy, req.URL.Host) {
+			return nil, nil
+		}
+		if proxyURL != nil {
+			return proxyURL, nil
+		}
+		return http.ProxyFromEnvironment(req)
+	}
+
+	return client
+}
+
+// readCABundle returns the PEM encoded certificates of a CA bundle, given
+// either as the contents or as the path of a file.
+func readCABundle(bundle string) (string, error) {
+	if bundle == "" || strings.Contains(bundle, "-----BEGIN") {
+		return bundle, nil
+	}
+
+	path, err := homedir.Expand(bundle)
+	if err != nil {
+		return "", err
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Error reading CA bundle: %s", err)
+	}
+
+	return string(b), nil
+}
+
+// caBundleCertPool returns the system's certificate pool with the
+// certificates of a PEM encoded CA bundle added.
+func caBundleCertPool(bundle string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		log.Printf("[WARN] Unable to load the system certificate pool: %s", err)
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(bundle)) {
+		return nil, fmt.Errorf("CA bundle doesn't contain any PEM encoded certificates")
+	}
+
+	return pool, nil
+}
+
+// linkLocalHost reports whether a host, with an optional port, is a
+// link-local IP address or the IPv6 address of the EC2 instance metadata
+// service.
+func linkLocalHost(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	if ip == nil {
+		return false
+	}
+
+	return ip.IsLinkLocalUnicast() || ip.Equal(net.ParseIP("fd00:ec2::254"))
+}
+
+// noProxyMatch reports whether a host, with an optional port, is excluded
+// from proxying by a comma-separated list of host names, domains, IP
+// addresses and CIDR blocks, in the format of the NO_PROXY environment
+// variable. A host name matches itself and its subdomains, and "*" matches
+// every host.
+func noProxyMatch(noProxy, hostport string) bool {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host, port = hostport, ""
+	}
+	host = strings.ToLower(strings.Trim(host, "[]"))
+	ip := net.ParseIP(host)
+
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		entryHost, entryPort, err := net.SplitHostPort(entry)
+		if err != nil {
+			entryHost, entryPort = entry, ""
+		}
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+		entryHost = strings.Trim(entryHost, "[]")
+
+		if entryIP := net.ParseIP(entryHost); entryIP != nil {
+			if ip != nil && entryIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+
+		entryHost = strings.TrimPrefix(entryHost, "*")
+		entryHost = strings.TrimPrefix(entryHost, ".")
+		if host == entryHost || strings.HasSuffix(host, "."+entryHost) {
+			return true
+		}
+	}
+
+	return false
+}
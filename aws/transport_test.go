@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestNoProxyMatch(t *testing.T) {
+	cases := []struct {
+		NoProxy string
+		Host    string
+		Match   bool
+	}{
+		{"", "ec2.us-east-1.amazonaws.com", false},
+		{"*", "ec2.us-east-1.amazonaws.com", true},
+		{"amazonaws.com", "ec2.us-east-1.amazonaws.com", true},
+		{".amazonaws.com", "ec2.us-east-1.amazonaws.com:443", true},
+		{"*.amazonaws.com", "amazonaws.com", true},
+		{"example.com, amazonaws.com", "ec2.us-east-1.amazonaws.com", true},
+		{"zonaws.com", "ec2.us-east-1.amazonaws.com", false},
+		{"amazonaws.com:80", "ec2.us-east-1.amazonaws.com:443", false},
+		{"amazonaws.com:443", "ec2.us-east-1.amazonaws.com:443", true},
+		{"169.254.169.254", "169.254.169.254", true},
+		{"169.254.0.0/16", "169.254.169.254:80", true},
+		{"10.0.0.0/8", "169.254.169.254", false},
+		{"::1", "[::1]:8080", true},
+	}
+
+	for _, tc := range cases {
+		if match := noProxyMatch(tc.NoProxy, tc.Host); match != tc.Match {
+			t.Errorf("noProxyMatch(%q, %q): expected %t, got %t", tc.NoProxy, tc.Host, tc.Match, match)
+		}
+	}
+}
+
+func TestConfigHttpClient_proxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.Host)
+	}))
+	defer proxy.Close()
+
+	c := &Config{
+		HTTPProxy: proxy.URL,
+		NoProxy:   "direct.example.com",
+	}
+	client := c.httpClient()
+
+	resp, err := client.Get("http://sts.amazonaws.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if len(proxied) != 1 || proxied[0] != "sts.amazonaws.com" {
+		t.Fatalf("Expected the request to be sent through the proxy, got %q", proxied)
+	}
+
+	req, _ := http.NewRequest("GET", "http://direct.example.com/", nil)
+	u, err := client.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != nil {
+		t.Fatalf("Expected no proxy for a host listed in no_proxy, got %s", u)
+	}
+
+	for _, host := range []string{"169.254.169.254", "169.254.170.2:80", "[fd00:ec2::254]"} {
+		req, _ = http.NewRequest("GET", "http://"+host+"/latest/meta-data/", nil)
+		u, err = client.Transport.(*http.Transport).Proxy(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u != nil {
+			t.Fatalf("Expected no proxy for the link-local address %s, got %s", host, u)
+		}
+	}
+}
+
+func TestConfigHttpClient_customCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer ts.Close()
+
+	bundle := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: ts.TLS.Certificates[0].Certificate[0],
+	}))
+
+	if _, err := (&Config{}).httpClient().Get(ts.URL); err == nil {
+		t.Fatal("Expected an error for a server with an untrusted certificate")
+	}
+
+	resp, err := (&Config{CustomCABundle: bundle}).httpClient().Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Expected the custom CA bundle to be trusted: %s", err)
+	}
+	resp.Body.Close()
+
+	file := testWriteTempFile(t, "terraform_aws_ca_bundle", bundle)
+	defer os.Remove(file)
+	contents, err := readCABundle(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contents != bundle {
+		t.Fatalf("Expected the CA bundle to be read from %s", file)
+	}
+
+	if _, err := caBundleCertPool("not a certificate"); err == nil {
+		t.Fatal("Expected an error for a CA bundle without certificates")
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -371,21 +372,27 @@ func validateAwsAccountId(v interface{}, k string) (ws []string, errors []error)
 }
 
 func validateArn(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
+	return validateArnOf("", "")(v, k)
+}
 
-	if value == "" {
-		return
-	}
+func validateIamRoleArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnOf("iam", "role")(v, k)
+}
 
-	// http://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html
-	pattern := `^arn:[\w-]+:([a-zA-Z0-9\-])+:([a-z]{2}-(gov-)?[a-z]+-\d{1})?:(\d{12})?:(.*)$`
-	if !regexp.MustCompile(pattern).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q doesn't look like a valid ARN (%q): %q",
-			k, pattern, value))
-	}
+func validateIamPolicyArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnOf("iam", "policy")(v, k)
+}
 
-	return
+func validateSnsTopicArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnOf("sns", "")(v, k)
+}
+
+func validateKmsKeyArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnOf("kms", "")(v, k)
+}
+
+func validateLbTargetGroupArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnOf("elasticloadbalancing", "targetgroup")(v, k)
 }
 
 func validatePolicyStatementId(v interface{}, k string) (ws []string, errors []error) {
@@ -1368,6 +1375,222 @@ func validateCognitoIdentityProvidersProviderName(v interface{}, k string) (ws [
 	return
 }
 
+func validateCognitoIdentityPoolId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[\w-]+:[0-9a-f-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be an identity pool ID in the format REGION:GUID, got %q", k, value))
+	}
+
+	return
+}
+
+func validateCognitoRoleMappingIdentityProvider(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[\w._:/-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only alphanumeric characters, dots, underscores, colons, slashes and hyphens", k))
+		return
+	}
+
+	// User pools are mapped per app client
+	if strings.HasPrefix(value, "cognito-idp.") &&
+		!regexp.MustCompile(`^cognito-idp\.[\w-]+\.amazonaws\.com/[\w-]+_[0-9a-zA-Z]+:[\w]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be in the format cognito-idp.REGION.amazonaws.com/USER_POOL_ID:CLIENT_ID for user pools, got %q", k, value))
+	}
+
+	return
+}
+
+func validateCognitoRoleMappingRuleClaim(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 64 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[\p{L}\p{M}\p{S}\p{N}\p{P}]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only letters, marks, symbols, numbers and punctuation", k))
+	}
+
+	return
+}
+
+func validateCognitoRoles(v interface{}, k string) (ws []string, errors []error) {
+	for role, arn := range v.(map[string]interface{}) {
+		if role != "authenticated" && role != "unauthenticated" {
+			errors = append(errors, fmt.Errorf("%q: keys must be either authenticated or unauthenticated, got %q", k, role))
+			continue
+		}
+
+		// Values of maps are validated even when they aren't known yet
+		if arn == config.UnknownVariableValue {
+			continue
+		}
+		_, errs := validateIamRoleArn(arn, fmt.Sprintf("%s.%s", k, role))
+		errors = append(errors, errs...)
+	}
+
+	return
+}
+
+func validateCognitoUserPoolName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[\w\s+=,.@-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only alphanumeric characters, whitespaces and +=,.@- characters", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolSchemaName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 20 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 20 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[\p{L}\p{M}\p{S}\p{N}\p{P}]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only letters, marks, symbols, numbers and punctuation", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolEmailVerificationMessage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 6 || len(value) > 20000 {
+		errors = append(errors, fmt.Errorf("%q must be between 6 and 20000 characters long", k))
+	}
+
+	if !strings.Contains(value, "{####}") {
+		errors = append(errors, fmt.Errorf("%q must contain the {####} placeholder for the verification code", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolEmailVerificationMessageByLink(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 6 || len(value) > 20000 {
+		errors = append(errors, fmt.Errorf("%q must be between 6 and 20000 characters long", k))
+	}
+
+	if !regexp.MustCompile(`\{##[^#]*##\}`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain the {##Click Here##} placeholder for the verification link", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolSmsMessage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 6 || len(value) > 140 {
+		errors = append(errors, fmt.Errorf("%q must be between 6 and 140 characters long", k))
+	}
+
+	if !strings.Contains(value, "{####}") {
+		errors = append(errors, fmt.Errorf("%q must contain the {####} placeholder for the code", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolInviteTemplateEmailMessage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 6 || len(value) > 20000 {
+		errors = append(errors, fmt.Errorf("%q must be between 6 and 20000 characters long", k))
+	}
+
+	if !strings.Contains(value, "{username}") || !strings.Contains(value, "{####}") {
+		errors = append(errors, fmt.Errorf("%q must contain the {username} and {####} placeholders for the username and temporary password", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolInviteTemplateSmsMessage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 6 || len(value) > 140 {
+		errors = append(errors, fmt.Errorf("%q must be between 6 and 140 characters long", k))
+	}
+
+	if !strings.Contains(value, "{username}") || !strings.Contains(value, "{####}") {
+		errors = append(errors, fmt.Errorf("%q must contain the {username} and {####} placeholders for the username and temporary password", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolClientName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[\w\s+=,.@-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only alphanumeric characters, whitespaces and +=,.@- characters", k))
+	}
+
+	return
+}
+
+func validateCognitoUserPoolDomain(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 63 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only lowercase alphanumeric characters and hyphens, and must not start or end with a hyphen", k))
+	}
+
+	return
+}
+
+func validateCognitoUserGroupName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 128 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[\p{L}\p{M}\p{S}\p{N}\p{P}]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only letters, marks, symbols, numbers and punctuation", k))
+	}
+
+	return
+}
+
+func validateCognitoIdentityProviderName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 32 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 32 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[^_][\p{L}\p{M}\p{S}\p{N}\p{P}]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only letters, marks, symbols, numbers and punctuation, and must not start with an underscore", k))
+	}
+
+	return
+}
+
+func validateCognitoResourceServerScopeName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 256 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[\x21\x23-\x2E\x30-\x5B\x5D-\x7E]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only printable ASCII characters other than spaces, double quotes, slashes and backslashes", k))
+	}
+
+	return
+}
+
 func validateWafMetricName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9A-Za-z]+$`).MatchString(value) {
@@ -1427,3 +1650,51 @@ func validateBatchComputeEnvironmentName(v interface{}, k string) (ws []string,
 	}
 	return
 }
+
+func validateBatchName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]{0,127}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be up to 128 letters (uppercase and lowercase), numbers, hyphens and underscores, starting with a letter or number: %q", k, value))
+	}
+	return
+}
+
+func validateBatchJobContainerProperties(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandBatchJobContainerProperties(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid container properties: %s", k, err))
+	}
+	return
+}
+
+func validateHttpProxyUrl(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid URL: %s", k, err))
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		errors = append(errors, fmt.Errorf("%q must be an http or https URL, got: %s", k, value))
+	}
+	if u.Host == "" {
+		errors = append(errors, fmt.Errorf("%q must contain a host, got: %s", k, value))
+	}
+	return
+}
+
+func validateAthenaDatabaseName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 255 characters long", k))
+	}
+
+	if !regexp.MustCompile(`^[_a-z0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must contain only lowercase alphanumeric characters and underscores", k))
+	}
+
+	return
+}